@@ -0,0 +1,84 @@
+package bfs
+
+import "math/rand"
+
+// Betweenness computes the betweenness centrality of every vertex using Brandes' algorithm.
+// Each unordered pair of vertices is counted once. When normalized is true the scores are
+// divided by (n-1)(n-2)/2, the number of pairs that do not include the vertex itself.
+func (g *Graph) Betweenness(normalized bool) map[int]float64 {
+	vertices := g.VertexList()
+	return g.betweenness(vertices, vertices, normalized)
+}
+
+// ApproxBetweenness estimates betweenness centrality from a random sample of pivot sources.
+// Contributions are scaled by n/pivots so the result is an unbiased estimate of Betweenness.
+// A pivots value of at least n computes the exact scores.
+func (g *Graph) ApproxBetweenness(pivots int, seed int64, normalized bool) map[int]float64 {
+	vertices := g.VertexList()
+	if pivots >= len(vertices) || pivots <= 0 {
+		return g.betweenness(vertices, vertices, normalized)
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	sources := make([]int, pivots)
+	for i, idx := range rng.Perm(len(vertices))[:pivots] {
+		sources[i] = vertices[idx]
+	}
+	return g.betweenness(vertices, sources, normalized)
+}
+
+// betweenness accumulates Brandes' dependency scores from the given sources
+func (g *Graph) betweenness(vertices, sources []int, normalized bool) map[int]float64 {
+	centrality := make(map[int]float64, len(vertices))
+	for _, v := range vertices {
+		centrality[v] = 0
+	}
+
+	for _, s := range sources {
+		// Single-source shortest paths, recording vertices in order of distance
+		order := make([]int, 0, len(vertices))
+		preds := make(map[int][]int)
+		sigma := map[int]float64{s: 1}
+		dist := map[int]int{s: 0}
+		queue := Queue{s}
+
+		for !queue.IsEmpty() {
+			v := queue.Dequeue().(int)
+			order = append(order, v)
+
+			for _, w := range g.AdjList[v] {
+				if _, seen := dist[w]; !seen {
+					dist[w] = dist[v] + 1
+					queue.Enqueue(w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					preds[w] = append(preds[w], v)
+				}
+			}
+		}
+
+		// Back-propagate dependencies from the farthest vertices
+		delta := make(map[int]float64, len(order))
+		for i := len(order) - 1; i >= 0; i-- {
+			w := order[i]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				centrality[w] += delta[w]
+			}
+		}
+	}
+
+	// Every undirected pair was counted from both endpoints
+	scale := 0.5 * float64(len(vertices)) / float64(max(len(sources), 1))
+	n := float64(len(vertices))
+	if normalized && n > 2 {
+		scale *= 2 / ((n - 1) * (n - 2))
+	}
+	for v := range centrality {
+		centrality[v] *= scale
+	}
+	return centrality
+}
@@ -0,0 +1,84 @@
+package bfs
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestBetweenness(t *testing.T) {
+	g := createTestGraph()
+
+	result := g.Betweenness(false)
+	expected := map[int]float64{1: 9, 2: 9, 3: 9, 4: 0, 5: 0, 6: 0, 7: 0}
+
+	for v, want := range expected {
+		if math.Abs(result[v]-want) > 1e-9 {
+			t.Errorf("Betweenness(false)[%d] = %f; want %f", v, result[v], want)
+		}
+	}
+
+	// 15 pairs exclude any given vertex in a 7-vertex graph
+	normalized := g.Betweenness(true)
+	if math.Abs(normalized[1]-9.0/15.0) > 1e-9 {
+		t.Errorf("Betweenness(true)[1] = %f; want %f", normalized[1], 9.0/15.0)
+	}
+}
+
+func TestBetweennessMultiplePaths(t *testing.T) {
+	// Square 1-2-3-4-1: the pair (1, 3) has two shortest paths
+	g := NewGraph(4)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 1)
+
+	result := g.Betweenness(false)
+	for v := 1; v <= 4; v++ {
+		if math.Abs(result[v]-0.5) > 1e-9 {
+			t.Errorf("Betweenness(false)[%d] on square = %f; want 0.5", v, result[v])
+		}
+	}
+}
+
+func TestBetweennessIsolatedVertex(t *testing.T) {
+	// Path 1-2-3 plus an added vertex 4: the normalization counts four vertices
+	g := createPathGraph(3)
+	g.AddVertex(4)
+
+	result := g.Betweenness(true)
+	expected := map[int]float64{1: 0, 2: 1.0 / 3.0, 3: 0, 4: 0}
+	if len(result) != len(expected) {
+		t.Errorf("Betweenness(true) = %v; want %v", result, expected)
+	}
+	for v, want := range expected {
+		if math.Abs(result[v]-want) > 1e-9 {
+			t.Errorf("Betweenness(true)[%d] = %f; want %f", v, result[v], want)
+		}
+	}
+}
+
+func TestApproxBetweenness(t *testing.T) {
+	g := createPathGraph(50)
+
+	// Using every vertex as a pivot is exact
+	exact := g.Betweenness(true)
+	if all := g.ApproxBetweenness(50, 1, true); !reflect.DeepEqual(all, exact) {
+		t.Errorf("ApproxBetweenness with all pivots = %v; want %v", all, exact)
+	}
+
+	// Sampling is deterministic for a fixed seed
+	first := g.ApproxBetweenness(10, 42, false)
+	second := g.ApproxBetweenness(10, 42, false)
+	if !reflect.DeepEqual(first, second) {
+		t.Error("ApproxBetweenness should be deterministic for a fixed seed")
+	}
+
+	// Endpoints of a path are never between other vertices
+	if first[1] != 0 || first[50] != 0 {
+		t.Errorf("ApproxBetweenness endpoints = %f, %f; want 0", first[1], first[50])
+	}
+	if first[25] <= 0 {
+		t.Errorf("ApproxBetweenness[25] = %f; want positive", first[25])
+	}
+}
@@ -37,6 +37,15 @@ func NewGraph(vertices int) *Graph {
 	}
 }
 
+// AddVertex adds v without any edges. Vertex ids are arbitrary, so the graph's
+// vertices are the keys of AdjList: an isolated vertex only takes part in
+// metrics such as closeness and betweenness once it has been added.
+func (g *Graph) AddVertex(v int) {
+	if _, ok := g.AdjList[v]; !ok {
+		g.AdjList[v] = []int{}
+	}
+}
+
 // AddEdge adds an undirected edge between vertices v1 and v2
 func (g *Graph) AddEdge(v1, v2 int) {
 	g.AdjList[v1] = append(g.AdjList[v1], v2)
@@ -45,46 +54,17 @@ func (g *Graph) AddEdge(v1, v2 int) {
 
 // SimpleBFS performs basic BFS traversal starting from given vertex
 func (g *Graph) SimpleBFS(start int) []int {
-	visited := make(map[int]bool)
 	result := make([]int, 0)
-	queue := Queue{start}
-	visited[start] = true
-
-	for !queue.IsEmpty() {
-		vertex := queue.Dequeue().(int)
-		result = append(result, vertex)
-
-		for _, neighbor := range g.AdjList[vertex] {
-			if !visited[neighbor] {
-				visited[neighbor] = true
-				queue.Enqueue(neighbor)
-			}
-		}
-	}
+	g.Walk([]int{start}, func(v Visit) VisitAction {
+		result = append(result, v.Vertex)
+		return Continue
+	})
 	return result
 }
 
 // BFSWithDistance finds shortest distances from start vertex to all other vertices
 func (g *Graph) BFSWithDistance(start int) map[int]int {
-	distances := make(map[int]int)
-	visited := make(map[int]bool)
-	queue := Queue{start}
-
-	distances[start] = 0
-	visited[start] = true
-
-	for !queue.IsEmpty() {
-		vertex := queue.Dequeue().(int)
-
-		for _, neighbor := range g.AdjList[vertex] {
-			if !visited[neighbor] {
-				visited[neighbor] = true
-				distances[neighbor] = distances[vertex] + 1
-				queue.Enqueue(neighbor)
-			}
-		}
-	}
-	return distances
+	return g.BFSMultiSource([]int{start})
 }
 
 // BFSShortestPath finds shortest path between start and end vertices
@@ -93,85 +73,51 @@ func (g *Graph) BFSShortestPath(start, end int) []int {
 		return []int{start}
 	}
 
-	visited := make(map[int]bool)
 	parent := make(map[int]int)
-	queue := Queue{start}
-	visited[start] = true
-
-	for !queue.IsEmpty() {
-		vertex := queue.Dequeue().(int)
-
-		for _, neighbor := range g.AdjList[vertex] {
-			if !visited[neighbor] {
-				visited[neighbor] = true
-				parent[neighbor] = vertex
-				queue.Enqueue(neighbor)
-
-				if neighbor == end {
-					// Reconstruct path
-					path := []int{end}
-					for current := end; current != start; current = parent[current] {
-						path = append([]int{parent[current]}, path...)
-					}
-					return path
-				}
-			}
+	found := false
+	g.Walk([]int{start}, func(v Visit) VisitAction {
+		parent[v.Vertex] = v.Parent
+		if v.Vertex == end {
+			found = true
+			return Stop
 		}
+		return Continue
+	})
+	if !found {
+		return nil // No path found
+	}
+
+	// Reconstruct path
+	path := []int{end}
+	for current := end; current != start; current = parent[current] {
+		path = append(path, parent[current])
 	}
-	return nil // No path found
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
 }
 
 // BFSLevelOrder performs level-order traversal and returns nodes by levels
 func (g *Graph) BFSLevelOrder(start int) [][]int {
 	result := make([][]int, 0)
-	visited := make(map[int]bool)
-	queue := Queue{start}
-	visited[start] = true
-
-	for !queue.IsEmpty() {
-		levelSize := len(queue)
-		currentLevel := make([]int, 0)
-
-		for i := 0; i < levelSize; i++ {
-			vertex := queue.Dequeue().(int)
-			currentLevel = append(currentLevel, vertex)
-
-			for _, neighbor := range g.AdjList[vertex] {
-				if !visited[neighbor] {
-					visited[neighbor] = true
-					queue.Enqueue(neighbor)
-				}
-			}
+	g.Walk([]int{start}, func(v Visit) VisitAction {
+		if v.Depth == len(result) {
+			result = append(result, make([]int, 0))
 		}
-		result = append(result, currentLevel)
-	}
+		result[v.Depth] = append(result[v.Depth], v.Vertex)
+		return Continue
+	})
 	return result
 }
 
 // BFSMultiSource performs BFS from multiple source vertices simultaneously
 func (g *Graph) BFSMultiSource(sources []int) map[int]int {
 	distances := make(map[int]int)
-	visited := make(map[int]bool)
-	queue := make(Queue, 0)
-
-	// Initialize with all source vertices
-	for _, source := range sources {
-		queue.Enqueue(source)
-		distances[source] = 0
-		visited[source] = true
-	}
-
-	for !queue.IsEmpty() {
-		vertex := queue.Dequeue().(int)
-
-		for _, neighbor := range g.AdjList[vertex] {
-			if !visited[neighbor] {
-				visited[neighbor] = true
-				distances[neighbor] = distances[vertex] + 1
-				queue.Enqueue(neighbor)
-			}
-		}
-	}
+	g.Walk(sources, func(v Visit) VisitAction {
+		distances[v.Vertex] = v.Depth
+		return Continue
+	})
 	return distances
 }
 
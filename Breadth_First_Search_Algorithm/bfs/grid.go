@@ -0,0 +1,189 @@
+package bfs
+
+// Cell identifies a position in a 2D grid
+type Cell struct {
+	Row, Col int
+}
+
+// Neighborhood selects which cells are adjacent in a grid
+type Neighborhood int
+
+const (
+	// FourWay connects cells that share an edge
+	FourWay Neighborhood = 4
+	// EightWay also connects cells that share a corner
+	EightWay Neighborhood = 8
+)
+
+var fourWayOffsets = []Cell{{-1, 0}, {0, 1}, {1, 0}, {0, -1}}
+
+var eightWayOffsets = []Cell{{-1, 0}, {-1, 1}, {0, 1}, {1, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, -1}}
+
+// Grid wraps a 2D slice for BFS. Passable decides which cells may be entered.
+// Rows may have different lengths.
+type Grid[T any] struct {
+	Cells        [][]T
+	Passable     func(T) bool
+	Neighborhood Neighborhood
+}
+
+// NewGrid creates a grid over cells. A nil passable treats every cell as passable.
+func NewGrid[T any](cells [][]T, passable func(T) bool, neighborhood Neighborhood) *Grid[T] {
+	if passable == nil {
+		passable = func(T) bool { return true }
+	}
+	return &Grid[T]{
+		Cells:        cells,
+		Passable:     passable,
+		Neighborhood: neighborhood,
+	}
+}
+
+// InBounds checks if the cell lies inside the grid
+func (g *Grid[T]) InBounds(c Cell) bool {
+	return c.Row >= 0 && c.Row < len(g.Cells) && c.Col >= 0 && c.Col < len(g.Cells[c.Row])
+}
+
+// IsOpen checks if the cell lies inside the grid and is passable
+func (g *Grid[T]) IsOpen(c Cell) bool {
+	return g.InBounds(c) && g.Passable(g.Cells[c.Row][c.Col])
+}
+
+// Neighbors returns the open cells adjacent to c
+func (g *Grid[T]) Neighbors(c Cell) []Cell {
+	offsets := fourWayOffsets
+	if g.Neighborhood == EightWay {
+		offsets = eightWayOffsets
+	}
+
+	result := make([]Cell, 0, len(offsets))
+	for _, d := range offsets {
+		next := Cell{c.Row + d.Row, c.Col + d.Col}
+		if g.IsOpen(next) {
+			result = append(result, next)
+		}
+	}
+	return result
+}
+
+// NearestSource runs multi-source BFS and returns, for every cell, the distance to the
+// nearest source and the index of that source in sources. Unreached cells hold -1 in both.
+func (g *Grid[T]) NearestSource(sources []Cell) ([][]int, [][]int) {
+	distances := g.newIntGrid(-1)
+	nearest := g.newIntGrid(-1)
+	queue := make(Queue, 0)
+
+	for i, source := range sources {
+		if !g.IsOpen(source) || distances[source.Row][source.Col] != -1 {
+			continue
+		}
+		distances[source.Row][source.Col] = 0
+		nearest[source.Row][source.Col] = i
+		queue.Enqueue(source)
+	}
+
+	for !queue.IsEmpty() {
+		cell := queue.Dequeue().(Cell)
+
+		for _, next := range g.Neighbors(cell) {
+			if distances[next.Row][next.Col] == -1 {
+				distances[next.Row][next.Col] = distances[cell.Row][cell.Col] + 1
+				nearest[next.Row][next.Col] = nearest[cell.Row][cell.Col]
+				queue.Enqueue(next)
+			}
+		}
+	}
+	return distances, nearest
+}
+
+// DistanceMap returns the BFS distance from every cell to the nearest source, or -1 if unreachable
+func (g *Grid[T]) DistanceMap(sources []Cell) [][]int {
+	distances, _ := g.NearestSource(sources)
+	return distances
+}
+
+// SpreadTime simulates spreading from the sources one step per time unit
+// (the "rotting oranges" problem). It returns the number of steps until no new cell
+// is reached, and whether every open cell was reached.
+func (g *Grid[T]) SpreadTime(sources []Cell) (int, bool) {
+	steps := 0
+	complete := true
+	for r, row := range g.DistanceMap(sources) {
+		for c, d := range row {
+			if d > steps {
+				steps = d
+			}
+			if d == -1 && g.IsOpen(Cell{r, c}) {
+				complete = false
+			}
+		}
+	}
+	return steps, complete
+}
+
+// FloodFill returns every open cell connected to start, in BFS order
+func (g *Grid[T]) FloodFill(start Cell) []Cell {
+	labels := g.newIntGrid(0)
+	return g.fill(labels, start, 1)
+}
+
+// LabelRegions labels each connected region of open cells with 1, 2, ... in row-major
+// order of their first cell. Blocked cells are labelled 0. The size of region k is
+// stored at sizes[k-1].
+func (g *Grid[T]) LabelRegions() (labels [][]int, sizes []int) {
+	labels = g.newIntGrid(0)
+	sizes = make([]int, 0)
+
+	for r, row := range g.Cells {
+		for c := range row {
+			cell := Cell{r, c}
+			if labels[r][c] == 0 && g.IsOpen(cell) {
+				region := g.fill(labels, cell, len(sizes)+1)
+				sizes = append(sizes, len(region))
+			}
+		}
+	}
+	return labels, sizes
+}
+
+// CountRegions returns the number of connected regions of open cells (the "island count")
+func (g *Grid[T]) CountRegions() int {
+	_, sizes := g.LabelRegions()
+	return len(sizes)
+}
+
+// fill writes label into every unlabelled open cell connected to start
+func (g *Grid[T]) fill(labels [][]int, start Cell, label int) []Cell {
+	region := make([]Cell, 0)
+	if !g.IsOpen(start) || labels[start.Row][start.Col] != 0 {
+		return region
+	}
+
+	labels[start.Row][start.Col] = label
+	queue := Queue{start}
+
+	for !queue.IsEmpty() {
+		cell := queue.Dequeue().(Cell)
+		region = append(region, cell)
+
+		for _, next := range g.Neighbors(cell) {
+			if labels[next.Row][next.Col] == 0 {
+				labels[next.Row][next.Col] = label
+				queue.Enqueue(next)
+			}
+		}
+	}
+	return region
+}
+
+// newIntGrid allocates an int grid shaped like the cells, filled with value
+func (g *Grid[T]) newIntGrid(value int) [][]int {
+	result := make([][]int, len(g.Cells))
+	for r, row := range g.Cells {
+		result[r] = make([]int, len(row))
+		for c := range result[r] {
+			result[r][c] = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,95 @@
+package bfs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGridSpreadTime(t *testing.T) {
+	// Rotting oranges: 2 = rotten, 1 = fresh, 0 = empty
+	oranges := [][]int{
+		{2, 1, 1},
+		{1, 1, 0},
+		{0, 1, 1},
+	}
+	g := NewGrid(oranges, func(v int) bool { return v != 0 }, FourWay)
+
+	steps, complete := g.SpreadTime([]Cell{{0, 0}})
+	if steps != 4 || !complete {
+		t.Errorf("SpreadTime = (%d, %t); want (4, true)", steps, complete)
+	}
+
+	// An isolated fresh orange is never reached
+	oranges[2][0], oranges[1][0] = 1, 0
+	oranges[2][1] = 0
+	if _, complete := g.SpreadTime([]Cell{{0, 0}}); complete {
+		t.Error("SpreadTime should report incomplete spread for isolated cells")
+	}
+}
+
+func TestGridNearestSource(t *testing.T) {
+	g := NewGrid([][]byte{
+		[]byte("....."),
+		[]byte(".###."),
+		[]byte("....."),
+	}, func(b byte) bool { return b != '#' }, FourWay)
+
+	distances, nearest := g.NearestSource([]Cell{{0, 0}, {2, 4}})
+
+	expectedDist := [][]int{
+		{0, 1, 2, 3, 2},
+		{1, -1, -1, -1, 1},
+		{2, 3, 2, 1, 0},
+	}
+	if !reflect.DeepEqual(distances, expectedDist) {
+		t.Errorf("NearestSource distances = %v; want %v", distances, expectedDist)
+	}
+	if nearest[0][1] != 0 || nearest[2][3] != 1 || nearest[1][1] != -1 {
+		t.Errorf("NearestSource owners = %v", nearest)
+	}
+}
+
+func TestGridLabelRegions(t *testing.T) {
+	islands := [][]rune{
+		[]rune("11000"),
+		[]rune("11010"),
+		[]rune("00001"),
+	}
+	land := func(r rune) bool { return r == '1' }
+
+	labels, sizes := NewGrid(islands, land, FourWay).LabelRegions()
+	expectedLabels := [][]int{
+		{1, 1, 0, 0, 0},
+		{1, 1, 0, 2, 0},
+		{0, 0, 0, 0, 3},
+	}
+	if !reflect.DeepEqual(labels, expectedLabels) {
+		t.Errorf("LabelRegions labels = %v; want %v", labels, expectedLabels)
+	}
+	if !reflect.DeepEqual(sizes, []int{4, 1, 1}) {
+		t.Errorf("LabelRegions sizes = %v; want [4 1 1]", sizes)
+	}
+
+	// Diagonal neighbours merge the two single cells
+	if count := NewGrid(islands, land, EightWay).CountRegions(); count != 2 {
+		t.Errorf("CountRegions with EightWay = %d; want 2", count)
+	}
+}
+
+func TestGridFloodFill(t *testing.T) {
+	g := NewGrid([][]int{
+		{1, 1, 0},
+		{0, 1, 0},
+		{1, 0, 1},
+	}, func(v int) bool { return v == 1 }, FourWay)
+
+	region := g.FloodFill(Cell{0, 0})
+	expected := []Cell{{0, 0}, {0, 1}, {1, 1}}
+	if !reflect.DeepEqual(region, expected) {
+		t.Errorf("FloodFill(0, 0) = %v; want %v", region, expected)
+	}
+
+	if region := g.FloodFill(Cell{0, 2}); len(region) != 0 {
+		t.Errorf("FloodFill on blocked cell = %v; want empty", region)
+	}
+}
@@ -0,0 +1,97 @@
+package bfs
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// KHopNeighborhood returns every vertex within k hops of any seed, mapped to its
+// distance from the nearest seed. Traversal stops at depth k instead of covering
+// the whole component.
+func (g *Graph) KHopNeighborhood(seeds []int, k int) map[int]int {
+	distances := make(map[int]int)
+	if k < 0 {
+		return distances
+	}
+	g.Walk(seeds, func(v Visit) VisitAction {
+		distances[v.Vertex] = v.Depth
+		if v.Depth == k {
+			return Prune
+		}
+		return Continue
+	})
+	return distances
+}
+
+// EgoNetwork returns the subgraph induced by the vertices within k hops of the seeds.
+// Edges keep their original order and multiplicity.
+func (g *Graph) EgoNetwork(seeds []int, k int) *Graph {
+	members := g.KHopNeighborhood(seeds, k)
+	ego := NewGraph(len(members))
+
+	for v := range members {
+		ego.AdjList[v] = make([]int, 0)
+		for _, neighbor := range g.AdjList[v] {
+			if _, ok := members[neighbor]; ok {
+				ego.AdjList[v] = append(ego.AdjList[v], neighbor)
+			}
+		}
+	}
+	return ego
+}
+
+// BatchKHop returns the sorted k-hop neighbourhood of every seed.
+// Seeds are processed 64 at a time with a bit-parallel BFS: each vertex keeps one bit
+// per seed, so overlapping neighbourhoods are expanded once for the whole batch.
+func (g *Graph) BatchKHop(seeds []int, k int) map[int][]int {
+	result := make(map[int][]int)
+	unique := make([]int, 0, len(seeds))
+	for _, seed := range seeds {
+		if _, ok := result[seed]; !ok {
+			result[seed] = make([]int, 0)
+			unique = append(unique, seed)
+		}
+	}
+	if k < 0 {
+		return result
+	}
+
+	for start := 0; start < len(unique); start += 64 {
+		batch := unique[start:min(start+64, len(unique))]
+
+		seen := make(map[int]uint64)
+		frontier := make(map[int]uint64)
+		for i, seed := range batch {
+			seen[seed] |= 1 << i
+			frontier[seed] |= 1 << i
+		}
+
+		for depth := 0; depth < k && len(frontier) > 0; depth++ {
+			next := make(map[int]uint64)
+			for v, mask := range frontier {
+				for _, neighbor := range g.AdjList[v] {
+					if fresh := mask &^ seen[neighbor]; fresh != 0 {
+						next[neighbor] |= fresh
+					}
+				}
+			}
+			for v, mask := range next {
+				seen[v] |= mask
+			}
+			frontier = next
+		}
+
+		for v, mask := range seen {
+			for mask != 0 {
+				i := bits.TrailingZeros64(mask)
+				result[batch[i]] = append(result[batch[i]], v)
+				mask &= mask - 1
+			}
+		}
+	}
+
+	for _, members := range result {
+		sort.Ints(members)
+	}
+	return result
+}
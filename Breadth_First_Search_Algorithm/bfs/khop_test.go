@@ -0,0 +1,86 @@
+package bfs
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestKHopNeighborhood(t *testing.T) {
+	g := createPathGraph(10)
+
+	result := g.KHopNeighborhood([]int{5}, 2)
+	expected := map[int]int{3: 2, 4: 1, 5: 0, 6: 1, 7: 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("KHopNeighborhood([5], 2) = %v; want %v", result, expected)
+	}
+
+	// Multiple seeds report the distance to the nearest one
+	result = g.KHopNeighborhood([]int{1, 10}, 1)
+	expected = map[int]int{1: 0, 2: 1, 9: 1, 10: 0}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("KHopNeighborhood([1 10], 1) = %v; want %v", result, expected)
+	}
+
+	if result := g.KHopNeighborhood([]int{5}, 0); !reflect.DeepEqual(result, map[int]int{5: 0}) {
+		t.Errorf("KHopNeighborhood([5], 0) = %v; want map[5:0]", result)
+	}
+}
+
+func TestEgoNetwork(t *testing.T) {
+	g := createTestGraph()
+
+	ego := g.EgoNetwork([]int{2}, 1)
+	expected := map[int][]int{
+		1: {2},
+		2: {1, 4, 5},
+		4: {2},
+		5: {2},
+	}
+	if !reflect.DeepEqual(ego.AdjList, expected) {
+		t.Errorf("EgoNetwork([2], 1) = %v; want %v", ego.AdjList, expected)
+	}
+	if ego.Vertices != 4 {
+		t.Errorf("EgoNetwork vertex count = %d; want 4", ego.Vertices)
+	}
+}
+
+func TestBatchKHop(t *testing.T) {
+	g := createTestGraph()
+
+	// Compare against single-seed BFS for every vertex, with duplicates
+	seeds := []int{1, 2, 3, 4, 5, 6, 7, 2}
+	for _, k := range []int{0, 1, 2, 3} {
+		batch := g.BatchKHop(seeds, k)
+		if len(batch) != 7 {
+			t.Errorf("BatchKHop(k=%d) returned %d seeds; want 7", k, len(batch))
+		}
+		for _, seed := range seeds {
+			single := make([]int, 0)
+			for v := range g.KHopNeighborhood([]int{seed}, k) {
+				single = append(single, v)
+			}
+			sort.Ints(single)
+			if !reflect.DeepEqual(batch[seed], single) {
+				t.Errorf("BatchKHop(k=%d)[%d] = %v; want %v", k, seed, batch[seed], single)
+			}
+		}
+	}
+}
+
+func TestBatchKHopManySeeds(t *testing.T) {
+	// More than 64 seeds spans several bit-parallel batches
+	g := createPathGraph(150)
+	seeds := make([]int, 0, 150)
+	for v := 1; v <= 150; v++ {
+		seeds = append(seeds, v)
+	}
+
+	batch := g.BatchKHop(seeds, 3)
+	if !reflect.DeepEqual(batch[100], []int{97, 98, 99, 100, 101, 102, 103}) {
+		t.Errorf("BatchKHop[100] = %v", batch[100])
+	}
+	if !reflect.DeepEqual(batch[150], []int{147, 148, 149, 150}) {
+		t.Errorf("BatchKHop[150] = %v", batch[150])
+	}
+}
@@ -0,0 +1,167 @@
+package bfs
+
+import "sort"
+
+// MetricMode selects how distance-based graph metrics are computed
+type MetricMode int
+
+const (
+	// Exact runs a BFS from every vertex: O(V * (V + E))
+	Exact MetricMode = iota
+	// DoubleSweep runs two BFS passes per component: O(V + E).
+	// The diameter it reports is a lower bound, the radius an upper bound.
+	DoubleSweep
+)
+
+// VertexList returns all vertices present in the adjacency list in ascending order.
+// It is the vertex set every metric works on; isolated vertices need AddVertex.
+func (g *Graph) VertexList() []int {
+	vertices := make([]int, 0, len(g.AdjList))
+	for v := range g.AdjList {
+		vertices = append(vertices, v)
+	}
+	sort.Ints(vertices)
+	return vertices
+}
+
+// Eccentricity returns the greatest BFS distance from v to any vertex in its component
+func (g *Graph) Eccentricity(v int) int {
+	_, dist := farthest(g.BFSWithDistance(v))
+	return dist
+}
+
+// Eccentricities returns the eccentricity of every vertex in the graph.
+// Vertices in other components are ignored, so disconnected graphs yield finite values.
+func (g *Graph) Eccentricities() map[int]int {
+	result := make(map[int]int, len(g.AdjList))
+	for _, v := range g.VertexList() {
+		result[v] = g.Eccentricity(v)
+	}
+	return result
+}
+
+// Diameter returns the longest shortest path in the graph together with its endpoints.
+// For an empty graph it returns (0, -1, -1).
+func (g *Graph) Diameter(mode MetricMode) (length, from, to int) {
+	from, to = -1, -1
+	if mode == DoubleSweep {
+		for _, s := range g.doubleSweeps() {
+			if from == -1 || s.length > length {
+				length, from, to = s.length, s.from, s.to
+			}
+		}
+		return length, from, to
+	}
+
+	for _, v := range g.VertexList() {
+		far, dist := farthest(g.BFSWithDistance(v))
+		if from == -1 || dist > length {
+			length, from, to = dist, v, far
+		}
+	}
+	return length, from, to
+}
+
+// Radius returns the smallest eccentricity in the graph.
+// In DoubleSweep mode it returns the eccentricity of the approximate centre.
+func (g *Graph) Radius(mode MetricMode) int {
+	center := g.Center(mode)
+	if len(center) == 0 {
+		return 0
+	}
+	return g.Eccentricity(center[0])
+}
+
+// Center returns the vertices whose eccentricity equals the radius, in ascending order.
+// In DoubleSweep mode it returns the midpoint of the longest sweep path.
+func (g *Graph) Center(mode MetricMode) []int {
+	if mode == DoubleSweep {
+		best := sweep{from: -1}
+		for _, s := range g.doubleSweeps() {
+			if best.from == -1 || s.length > best.length {
+				best = s
+			}
+		}
+		if best.from == -1 {
+			return []int{}
+		}
+		path := g.BFSShortestPath(best.from, best.to)
+		return []int{path[len(path)/2]}
+	}
+
+	center := make([]int, 0)
+	radius := -1
+	for _, v := range g.VertexList() {
+		ecc := g.Eccentricity(v)
+		switch {
+		case radius == -1 || ecc < radius:
+			radius = ecc
+			center = []int{v}
+		case ecc == radius:
+			center = append(center, v)
+		}
+	}
+	return center
+}
+
+// Closeness returns the closeness centrality of v.
+// The score is (r-1)/sum(d) scaled by (r-1)/(n-1), where r is the number of vertices
+// reachable from v and n the number of vertices in the graph (Wasserman-Faust).
+func (g *Graph) Closeness(v int) float64 {
+	n := len(g.AdjList)
+	distances := g.BFSWithDistance(v)
+	reachable := len(distances)
+	if n <= 1 || reachable <= 1 {
+		return 0
+	}
+
+	total := 0
+	for _, d := range distances {
+		total += d
+	}
+	return float64(reachable-1) / float64(total) * float64(reachable-1) / float64(n-1)
+}
+
+// ClosenessCentrality returns the closeness centrality of every vertex in the graph
+func (g *Graph) ClosenessCentrality() map[int]float64 {
+	result := make(map[int]float64, len(g.AdjList))
+	for _, v := range g.VertexList() {
+		result[v] = g.Closeness(v)
+	}
+	return result
+}
+
+// sweep records the result of a double-sweep pass over one component
+type sweep struct {
+	length, from, to int
+}
+
+// doubleSweeps runs a double sweep from the smallest vertex of every component
+func (g *Graph) doubleSweeps() []sweep {
+	sweeps := make([]sweep, 0)
+	seen := make(map[int]bool)
+	for _, v := range g.VertexList() {
+		if seen[v] {
+			continue
+		}
+		first := g.BFSWithDistance(v)
+		for u := range first {
+			seen[u] = true
+		}
+		a, _ := farthest(first)
+		b, length := farthest(g.BFSWithDistance(a))
+		sweeps = append(sweeps, sweep{length: length, from: a, to: b})
+	}
+	return sweeps
+}
+
+// farthest returns the vertex with the largest distance, preferring the smallest id on ties
+func farthest(distances map[int]int) (vertex, dist int) {
+	vertex = -1
+	for v, d := range distances {
+		if vertex == -1 || d > dist || (d == dist && v < vertex) {
+			vertex, dist = v, d
+		}
+	}
+	return vertex, dist
+}
@@ -0,0 +1,131 @@
+package bfs
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Helper function to create a path graph 1 - 2 - ... - n
+func createPathGraph(n int) *Graph {
+	g := NewGraph(n)
+	for i := 1; i < n; i++ {
+		g.AddEdge(i, i+1)
+	}
+	return g
+}
+
+func TestEccentricities(t *testing.T) {
+	g := createTestGraph()
+
+	result := g.Eccentricities()
+	expected := map[int]int{1: 2, 2: 3, 3: 3, 4: 4, 5: 4, 6: 4, 7: 4}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Eccentricities() = %v; want %v", result, expected)
+	}
+}
+
+func TestDiameter(t *testing.T) {
+	g := createTestGraph()
+
+	for _, mode := range []MetricMode{Exact, DoubleSweep} {
+		length, from, to := g.Diameter(mode)
+		if length != 4 {
+			t.Errorf("Diameter(%d) length = %d; want 4", mode, length)
+		}
+		if got := g.BFSWithDistance(from)[to]; got != length {
+			t.Errorf("Diameter(%d) witness %d-%d has distance %d; want %d",
+				mode, from, to, got, length)
+		}
+	}
+
+	// Empty graph
+	if length, from, to := NewGraph(0).Diameter(Exact); length != 0 || from != -1 || to != -1 {
+		t.Errorf("Diameter of empty graph = (%d, %d, %d); want (0, -1, -1)", length, from, to)
+	}
+}
+
+func TestRadiusAndCenter(t *testing.T) {
+	g := createTestGraph()
+
+	if r := g.Radius(Exact); r != 2 {
+		t.Errorf("Radius(Exact) = %d; want 2", r)
+	}
+	if c := g.Center(Exact); !reflect.DeepEqual(c, []int{1}) {
+		t.Errorf("Center(Exact) = %v; want [1]", c)
+	}
+
+	// Path with an even number of vertices has two centres
+	path := createPathGraph(6)
+	if c := path.Center(Exact); !reflect.DeepEqual(c, []int{3, 4}) {
+		t.Errorf("Center(Exact) on path = %v; want [3 4]", c)
+	}
+
+	// Double sweep finds the exact centre on trees
+	if c := g.Center(DoubleSweep); !reflect.DeepEqual(c, []int{1}) {
+		t.Errorf("Center(DoubleSweep) = %v; want [1]", c)
+	}
+	if r := path.Radius(DoubleSweep); r != 3 {
+		t.Errorf("Radius(DoubleSweep) on path = %d; want 3", r)
+	}
+}
+
+func TestClosenessCentrality(t *testing.T) {
+	g := createPathGraph(3)
+
+	result := g.ClosenessCentrality()
+	expected := map[int]float64{1: 2.0 / 3.0, 2: 1, 3: 2.0 / 3.0}
+
+	for v, want := range expected {
+		if math.Abs(result[v]-want) > 1e-9 {
+			t.Errorf("Closeness(%d) = %f; want %f", v, result[v], want)
+		}
+	}
+}
+
+func TestClosenessIsolatedVertex(t *testing.T) {
+	g := NewGraph(4)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+
+	// Vertex 4 is not part of the graph until it is added
+	if c := g.Closeness(2); c != 1 {
+		t.Errorf("Closeness(2) = %f; want 1", c)
+	}
+	g.AddVertex(4)
+	g.AddVertex(2) // Must keep the edges of an existing vertex
+
+	result := g.ClosenessCentrality()
+	expected := map[int]float64{1: 4.0 / 9.0, 2: 2.0 / 3.0, 3: 4.0 / 9.0, 4: 0}
+	if len(result) != len(expected) {
+		t.Errorf("ClosenessCentrality = %v; want %v", result, expected)
+	}
+	for v, want := range expected {
+		if math.Abs(result[v]-want) > 1e-9 {
+			t.Errorf("Closeness(%d) with an isolated vertex = %f; want %f", v, result[v], want)
+		}
+	}
+	if ecc := g.Eccentricities(); len(ecc) != 4 || ecc[4] != 0 {
+		t.Errorf("Eccentricities = %v; want vertex 4 at 0", ecc)
+	}
+}
+
+func TestMetricsDisconnectedGraph(t *testing.T) {
+	g := NewGraph(5)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(4, 5)
+
+	if length, _, _ := g.Diameter(Exact); length != 2 {
+		t.Errorf("Diameter(Exact) on disconnected graph = %d; want 2", length)
+	}
+	if length, _, _ := g.Diameter(DoubleSweep); length != 2 {
+		t.Errorf("Diameter(DoubleSweep) on disconnected graph = %d; want 2", length)
+	}
+
+	// Vertex 4 reaches one of four other vertices at distance 1
+	if c := g.Closeness(4); math.Abs(c-0.25) > 1e-9 {
+		t.Errorf("Closeness(4) on disconnected graph = %f; want 0.25", c)
+	}
+}
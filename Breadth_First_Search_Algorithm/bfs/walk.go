@@ -0,0 +1,131 @@
+package bfs
+
+// Visit describes a vertex as it is discovered by a BFS traversal
+type Visit struct {
+	Vertex int
+	Parent int // -1 for source vertices
+	Depth  int
+}
+
+// VisitAction tells Walk how to proceed after a vertex has been discovered
+type VisitAction int
+
+const (
+	// Continue marks the vertex visited and expands its neighbours
+	Continue VisitAction = iota
+	// Prune marks the vertex visited but does not expand its neighbours,
+	// cutting off the part of the BFS tree below it
+	Prune
+	// Skip rejects this discovery; the vertex stays unvisited and may be
+	// discovered again through another parent
+	Skip
+	// Stop ends the traversal immediately
+	Stop
+)
+
+// Visitor is called once for every vertex discovery during Walk
+type Visitor func(v Visit) VisitAction
+
+// Walk performs BFS from the given sources, calling visit for each vertex in
+// discovery order. Vertices are reported before their neighbours are queued,
+// so stopping early avoids exploring the rest of the graph.
+func (g *Graph) Walk(sources []int, visit Visitor) {
+	visited := make(map[int]bool)
+	queue := make(Queue, 0)
+
+	// discover reports v and returns false if the traversal should stop
+	discover := func(v Visit) bool {
+		switch visit(v) {
+		case Stop:
+			return false
+		case Skip:
+			return true
+		case Prune:
+			visited[v.Vertex] = true
+		default:
+			visited[v.Vertex] = true
+			queue.Enqueue(v)
+		}
+		return true
+	}
+
+	for _, source := range sources {
+		if visited[source] {
+			continue
+		}
+		if !discover(Visit{Vertex: source, Parent: -1, Depth: 0}) {
+			return
+		}
+	}
+
+	for !queue.IsEmpty() {
+		current := queue.Dequeue().(Visit)
+
+		for _, neighbor := range g.AdjList[current.Vertex] {
+			if visited[neighbor] {
+				continue
+			}
+			next := Visit{Vertex: neighbor, Parent: current.Vertex, Depth: current.Depth + 1}
+			if !discover(next) {
+				return
+			}
+		}
+	}
+}
+
+// Find returns the first vertex in BFS order from start that satisfies match
+func (g *Graph) Find(start int, match func(v Visit) bool) (Visit, bool) {
+	var found Visit
+	ok := false
+	g.Walk([]int{start}, func(v Visit) VisitAction {
+		if match(v) {
+			found, ok = v, true
+			return Stop
+		}
+		return Continue
+	})
+	return found, ok
+}
+
+// Iterator yields BFS visits one at a time, expanding vertices lazily
+type Iterator struct {
+	graph   *Graph
+	visited map[int]bool
+	queue   Queue
+	last    *Visit
+	pruned  bool
+}
+
+// NewIterator creates an iterator over the BFS order from start
+func (g *Graph) NewIterator(start int) *Iterator {
+	return &Iterator{
+		graph:   g,
+		visited: map[int]bool{start: true},
+		queue:   Queue{Visit{Vertex: start, Parent: -1, Depth: 0}},
+	}
+}
+
+// Next returns the next vertex in BFS order, or false when the traversal is done
+func (it *Iterator) Next() (Visit, bool) {
+	if it.last != nil && !it.pruned {
+		for _, neighbor := range it.graph.AdjList[it.last.Vertex] {
+			if !it.visited[neighbor] {
+				it.visited[neighbor] = true
+				it.queue.Enqueue(Visit{Vertex: neighbor, Parent: it.last.Vertex, Depth: it.last.Depth + 1})
+			}
+		}
+	}
+	it.last, it.pruned = nil, false
+
+	if it.queue.IsEmpty() {
+		return Visit{}, false
+	}
+	current := it.queue.Dequeue().(Visit)
+	it.last = &current
+	return current, true
+}
+
+// Prune stops the iterator from expanding the neighbours of the last returned vertex
+func (it *Iterator) Prune() {
+	it.pruned = true
+}
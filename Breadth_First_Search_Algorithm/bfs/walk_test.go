@@ -0,0 +1,117 @@
+package bfs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWalkReportsParentAndDepth(t *testing.T) {
+	g := createTestGraph()
+
+	visits := make([]Visit, 0)
+	g.Walk([]int{1}, func(v Visit) VisitAction {
+		visits = append(visits, v)
+		return Continue
+	})
+
+	expected := []Visit{
+		{Vertex: 1, Parent: -1, Depth: 0},
+		{Vertex: 2, Parent: 1, Depth: 1},
+		{Vertex: 3, Parent: 1, Depth: 1},
+		{Vertex: 4, Parent: 2, Depth: 2},
+		{Vertex: 5, Parent: 2, Depth: 2},
+		{Vertex: 6, Parent: 3, Depth: 2},
+		{Vertex: 7, Parent: 3, Depth: 2},
+	}
+	if !reflect.DeepEqual(visits, expected) {
+		t.Errorf("Walk(1) visits = %v; want %v", visits, expected)
+	}
+}
+
+func TestWalkStop(t *testing.T) {
+	g := createTestGraph()
+
+	count := 0
+	g.Walk([]int{1}, func(v Visit) VisitAction {
+		count++
+		if v.Vertex == 3 {
+			return Stop
+		}
+		return Continue
+	})
+
+	if count != 3 {
+		t.Errorf("Walk should stop after 3 visits, got %d", count)
+	}
+}
+
+func TestWalkPrune(t *testing.T) {
+	g := createTestGraph()
+
+	result := make([]int, 0)
+	g.Walk([]int{1}, func(v Visit) VisitAction {
+		result = append(result, v.Vertex)
+		if v.Vertex == 2 {
+			return Prune
+		}
+		return Continue
+	})
+
+	expected := []int{1, 2, 3, 6, 7}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Walk with Prune(2) = %v; want %v", result, expected)
+	}
+}
+
+func TestWalkSkip(t *testing.T) {
+	// Square 1-2-3-4-1: rejecting 3 when reached from 2 lets 4 discover it
+	g := NewGraph(4)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 1)
+
+	parents := make(map[int]int)
+	g.Walk([]int{1}, func(v Visit) VisitAction {
+		if v.Vertex == 3 && v.Parent == 2 {
+			return Skip
+		}
+		parents[v.Vertex] = v.Parent
+		return Continue
+	})
+
+	if parents[3] != 4 {
+		t.Errorf("Skipped vertex 3 should be rediscovered from 4, got parent %d", parents[3])
+	}
+}
+
+func TestFind(t *testing.T) {
+	g := createTestGraph()
+
+	v, ok := g.Find(1, func(v Visit) bool { return v.Vertex > 4 })
+	if !ok || v.Vertex != 5 || v.Depth != 2 {
+		t.Errorf("Find(> 4) = %v, %t; want vertex 5 at depth 2", v, ok)
+	}
+
+	if _, ok := g.Find(1, func(v Visit) bool { return v.Vertex > 100 }); ok {
+		t.Error("Find should report false when no vertex matches")
+	}
+}
+
+func TestIterator(t *testing.T) {
+	g := createTestGraph()
+
+	it := g.NewIterator(1)
+	result := make([]int, 0)
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		result = append(result, v.Vertex)
+		if v.Vertex == 3 {
+			it.Prune()
+		}
+	}
+
+	expected := []int{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Iterator with Prune(3) = %v; want %v", result, expected)
+	}
+}
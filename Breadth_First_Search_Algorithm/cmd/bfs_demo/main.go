@@ -6,7 +6,7 @@ import (
 )
 
 func main() {
-	fmt.Println("=== Breadth-First Search Demonstrations ===\n")
+	fmt.Print("=== Breadth-First Search Demonstrations ===\n\n")
 
 	// Create a sample graph
 	/*
@@ -29,7 +29,7 @@ func main() {
 	fmt.Println("   /   \\")
 	fmt.Println("  2     3")
 	fmt.Println(" / \\   / \\")
-	fmt.Println("4   5 6   7\n")
+	fmt.Print("4   5 6   7\n\n")
 
 	// 1. Simple BFS Traversal
 	fmt.Println("1. Simple BFS Traversal from node 1:")
@@ -103,4 +103,13 @@ func main() {
 	fmt.Printf("BFS traversal from node 1: %v\n", disconnectedResult)
 	disconnectedResult = disconnected.SimpleBFS(4)
 	fmt.Printf("BFS traversal from node 4: %v\n", disconnectedResult)
+
+	// 8. Graph Metrics
+	fmt.Println("\n8. Graph Metrics on the sample tree:")
+	diameter, from, to := g.Diameter(bfs.Exact)
+	fmt.Printf("Diameter: %d (between %d and %d)\n", diameter, from, to)
+	approx, _, _ := g.Diameter(bfs.DoubleSweep)
+	fmt.Printf("Diameter (double sweep): %d\n", approx)
+	fmt.Printf("Radius: %d, Center: %v\n", g.Radius(bfs.Exact), g.Center(bfs.Exact))
+	fmt.Printf("Closeness of node 1: %.3f\n", g.Closeness(1))
 }
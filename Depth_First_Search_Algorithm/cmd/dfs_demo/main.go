@@ -0,0 +1,304 @@
+package main
+
+import (
+	"context"
+	"dsa/Depth_First_Search_Algorithm/dfs"
+	"fmt"
+	"os"
+)
+
+// printVisits prints a traversal order one vertex per line
+func printVisits(order []int) {
+	for _, node := range order {
+		fmt.Printf("Visited: %d\n", node)
+	}
+}
+
+func main() {
+	fmt.Println("=== Depth-First Search Demonstrations ===")
+
+	// Create sample graph
+	graph := map[int][]int{
+		1: {2, 3},
+		2: {4, 5},
+		3: {6},
+		4: {},
+		5: {},
+		6: {},
+	}
+
+	fmt.Println("Graph:", graph)
+	fmt.Println()
+
+	// 1. Recursive DFS
+	fmt.Println("1. Recursive DFS from node 1:")
+	visited := make(map[int]bool)
+	printVisits(dfs.DFSRecursive(graph, 1, visited))
+	fmt.Println()
+
+	// 2. Iterative DFS
+	fmt.Println("2. Iterative DFS from node 1:")
+	printVisits(dfs.DFSIterative(graph, 1))
+	fmt.Println()
+
+	// 3. Custom Stack DFS
+	fmt.Println("3. DFS with Custom Stack from node 1:")
+	printVisits(dfs.DFSWithCustomStack(graph, 1))
+	fmt.Println()
+
+	// 4. Tree Traversals
+	fmt.Println("4. Tree Traversals:")
+	// Build sample tree:    1
+	//                      / \
+	//                     2   3
+	//                    / \
+	//                   4   5
+	root := &dfs.TreeNode{Val: 1}
+	root.Left = &dfs.TreeNode{Val: 2}
+	root.Right = &dfs.TreeNode{Val: 3}
+	root.Left.Left = &dfs.TreeNode{Val: 4}
+	root.Left.Right = &dfs.TreeNode{Val: 5}
+
+	fmt.Printf("Pre-order:  %v\n", dfs.PreOrder(root))
+	fmt.Printf("In-order:   %v\n", dfs.InOrder(root))
+	fmt.Printf("Post-order: %v\n", dfs.PostOrder(root))
+	fmt.Printf("Pre-order (Iterative): %v\n", dfs.PreOrderIterative(root))
+	fmt.Printf("Max Depth: %d\n", dfs.MaxDepth(root))
+	fmt.Println()
+
+	// 5. Path Finding
+	fmt.Println("5. Path Finding:")
+	path := dfs.FindPath(graph, 1, 6)
+	fmt.Printf("Path from 1 to 6: %v\n", path)
+
+	allPaths := dfs.FindAllPaths(graph, 1, 6)
+	fmt.Printf("All paths from 1 to 6: %v\n", allPaths)
+	fmt.Println()
+
+	// 6. Cycle Detection
+	fmt.Println("6. Cycle Detection:")
+	// Undirected graph with cycle
+	cyclicGraph := map[int][]int{
+		1: {2, 3},
+		2: {1, 3},
+		3: {1, 2},
+	}
+	fmt.Printf("Has cycle (undirected): %t\n", dfs.HasCycleUndirected(cyclicGraph))
+
+	// Directed graph with cycle
+	directedCyclic := map[int][]int{
+		1: {2},
+		2: {3},
+		3: {1},
+	}
+	fmt.Printf("Has cycle (directed): %t\n", dfs.HasCycleDirected(directedCyclic))
+	fmt.Println()
+
+	// 7. Connected Components
+	fmt.Println("7. Connected Components:")
+	disconnectedGraph := map[int][]int{
+		1: {2},
+		2: {1},
+		3: {4},
+		4: {3},
+		5: {},
+	}
+	fmt.Printf("Number of components: %d\n", dfs.CountComponents(disconnectedGraph))
+	fmt.Printf("Components: %v\n", dfs.GetConnectedComponents(disconnectedGraph))
+	fmt.Printf("Is connected: %t\n", dfs.IsConnected(graph))
+	fmt.Println()
+
+	// 8. Topological Sort
+	fmt.Println("8. Topological Sort:")
+	dag := map[int][]int{
+		5: {2, 0},
+		4: {0, 1},
+		2: {3},
+		3: {1},
+		0: {},
+		1: {},
+	}
+	topoSort := dfs.TopologicalSort(dag)
+	fmt.Printf("Topological order: %v\n", topoSort)
+	fmt.Println()
+
+	// 9. Debug DFS
+	fmt.Println("9. Debug DFS (with depth limit):")
+	dfs.DFSDebug(os.Stdout, graph, 1, 3)
+
+	fmt.Println()
+
+	// 10. Strongly Connected Components
+	fmt.Println("10. Strongly Connected Components:")
+	dependencies := map[int][]int{
+		1: {2},
+		2: {3},
+		3: {1, 4},
+		4: {5},
+		5: {4, 6},
+	}
+	fmt.Printf("Tarjan:   %v\n", dfs.TarjanSCC(dependencies))
+	fmt.Printf("Kosaraju: %v\n", dfs.KosarajuSCC(dependencies))
+	condensed := dfs.Condense(dependencies)
+	fmt.Printf("Condensation DAG: %v\n", condensed.DAG)
+	fmt.Printf("Component order: %v\n", dfs.TopologicalSort(condensed.DAG))
+
+	fmt.Println()
+
+	// 11. Articulation Points and Bridges
+	fmt.Println("11. Articulation Points and Bridges:")
+	network := map[int][]int{
+		1: {2, 3},
+		2: {3},
+		3: {4},
+		4: {5, 6},
+		5: {6},
+		6: {7},
+	}
+	analysis := dfs.Biconnected(network)
+	fmt.Printf("Articulation points: %v\n", analysis.ArticulationPoints)
+	fmt.Printf("Bridges: %v\n", analysis.Bridges)
+	fmt.Printf("Biconnected components: %v\n", analysis.Components)
+
+	fmt.Println()
+
+	// 12. Eulerian Paths
+	fmt.Println("12. Eulerian Paths (Hierholzer):")
+	route := map[int][]int{
+		1: {2},
+		2: {3, 4},
+		3: {2},
+	}
+	if euler, err := dfs.EulerianPathDirected(route); err == nil {
+		fmt.Printf("Eulerian path: %v\n", euler)
+	}
+	if _, err := dfs.EulerianCircuitDirected(route); err != nil {
+		fmt.Printf("No circuit: %v\n", err)
+	}
+
+	fmt.Println()
+
+	// 13. Edge Classification
+	fmt.Println("13. DFS Forest and Edge Classification:")
+	build := map[int][]int{
+		1: {2, 3},
+		2: {3, 1},
+		3: {},
+		4: {3},
+	}
+	forest := dfs.BuildDFSForest(build)
+	for _, node := range []int{1, 2, 3, 4} {
+		fmt.Printf("Node %d: discovered %d, finished %d\n", node, forest.Discovery[node], forest.Finish[node])
+	}
+	for _, edge := range forest.Edges {
+		fmt.Printf("%d -> %d: %s\n", edge.From, edge.To, edge.Kind)
+	}
+	for _, back := range forest.BackEdges {
+		fmt.Printf("Cycle: %v\n", forest.Cycle(back))
+	}
+
+	fmt.Println()
+
+	// 14. Elementary Cycles
+	fmt.Println("14. Elementary Cycles (Johnson):")
+	circular := map[int][]int{
+		1: {2},
+		2: {3, 1},
+		3: {1, 3},
+	}
+	dfs.ElementaryCycles(circular, dfs.CycleLimits{MaxCount: 10}, func(cycle []int) bool {
+		fmt.Printf("Cycle: %v\n", cycle)
+		return true
+	})
+
+	fmt.Println()
+
+	// 15. Bounded Path Enumeration
+	fmt.Println("15. Bounded Path Enumeration:")
+	routes := map[int][]int{
+		1: {2, 3, 4},
+		2: {4},
+		3: {4},
+		4: {5, 6},
+		5: {7},
+		6: {7},
+	}
+	options := dfs.PathOptions{MaxLength: 4, Forbidden: []int{5}}
+	dfs.EnumeratePaths(context.Background(), routes, 1, 7, options, func(p []int) bool {
+		fmt.Printf("Path: %v\n", p)
+		return true
+	})
+	if total, err := dfs.CountPathsDAG(routes, 1, 7, dfs.PathOptions{}); err == nil {
+		fmt.Printf("Total paths from 1 to 7: %s\n", total)
+	}
+
+	fmt.Println()
+
+	// 16. Iterative Deepening on an implicit state space
+	fmt.Println("16. Iterative Deepening (reach 10 from 1 using x+1 and 2x):")
+	moves := func(x int) []int { return []int{x + 1, 2 * x} }
+	if solution, found := dfs.IterativeDeepening(1, func(x int) bool { return x == 10 }, moves, -1); found {
+		fmt.Printf("Shortest move sequence: %v\n", solution)
+	}
+
+	fmt.Println()
+
+	// 17. Backtracking
+	fmt.Println("17. Backtracking Puzzles:")
+	fmt.Printf("4-Queens solutions: %v\n", dfs.NQueens(4, 0))
+	fmt.Printf("8-Queens solution count: %d\n", dfs.CountNQueens(8))
+	fmt.Printf("Subsets of [3 34 4 12 5 2] summing to 9 (indices): %v\n",
+		dfs.SubsetSums([]int{3, 34, 4, 12, 5, 2}, 9, 0))
+
+	fmt.Println()
+
+	// 18. 2-SAT
+	fmt.Println("18. 2-SAT (feature flag compatibility):")
+	flags := dfs.NewTwoSAT()
+	darkMode, legacyUI := dfs.Literal{Var: "dark_mode"}, dfs.Literal{Var: "legacy_ui"}
+	flags.Require(darkMode)
+	flags.Conflict(darkMode, legacyUI)
+	if assignment, err := flags.Solve(); err == nil {
+		fmt.Printf("Assignment: %v\n", assignment)
+	}
+	flags.Require(legacyUI)
+	if _, err := flags.Solve(); err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println()
+
+	// 19. Lowest Common Ancestor
+	fmt.Println("19. Lowest Common Ancestor:")
+	ancestors := dfs.NewTreeLCA(root)
+	fmt.Printf("LCA(4, 5) = %d\n", ancestors.Ancestor(root.Left.Left, root.Left.Right).Val)
+	fmt.Printf("LCA(4, 3) = %d\n", ancestors.Ancestor(root.Left.Left, root.Right).Val)
+	fmt.Printf("Distance(4, 3) = %d\n", ancestors.Distance(root.Left.Left, root.Right))
+	if orgChart, err := dfs.NewLCA([]int{-1, 0, 0, 1, 1, 2}); err == nil {
+		fmt.Printf("Org chart: LCA(3, 5) = %d, 2nd ancestor of 4 = %d\n",
+			orgChart.QueryRMQ(3, 5), orgChart.KthAncestor(4, 2))
+	}
+
+	fmt.Println()
+
+	// 20. Dominator Trees
+	fmt.Println("20. Dominators (control-flow graph):")
+	flow := map[int][]int{1: {2}, 2: {3, 4}, 3: {5}, 4: {5}, 5: {6, 2}, 6: {}}
+	doms := dfs.ComputeDominators(flow, 1)
+	fmt.Printf("Immediate dominators: %v\n", doms.IDom)
+	fmt.Printf("Dominator tree: %v\n", doms.Tree)
+	fmt.Printf("Dominance frontiers: %v\n", doms.Frontier)
+	fmt.Printf("Immediate post-dominators: %v\n", dfs.ComputePostDominators(flow, 6).IDom)
+
+	fmt.Println()
+
+	// 21. Incremental Components
+	fmt.Println("21. Incremental Component Tracking:")
+	tracker := dfs.NewComponentTracker()
+	for _, edge := range [][2]int{{1, 2}, {3, 4}, {5, 6}, {2, 3}} {
+		tracker.AddEdge(edge[0], edge[1])
+		fmt.Printf("After %d-%d: %d components, size of 1's component = %d\n",
+			edge[0], edge[1], tracker.ComponentCount(), tracker.ComponentSize(1))
+	}
+	fmt.Printf("Snapshot: %v\n", tracker.GetConnectedComponents())
+}
@@ -0,0 +1,138 @@
+package dfs
+
+// === Backtracking Search ===
+
+// Backtracker is a generic backtracking engine over variables 0..Variables-1.
+// The caller owns the problem state and keeps it in sync through the hooks;
+// the engine decides which variable to assign next and explores with an explicit
+// stack, calling Choose before descending and Unchoose when backtracking.
+type Backtracker struct {
+	Variables int
+
+	// Candidates returns the values that may still be assigned to variable given
+	// the current state, as a slice the engine may keep. Returning only consistent
+	// values prunes the search.
+	Candidates func(variable int) []int
+
+	// Accept optionally rejects a candidate before it is chosen
+	Accept func(variable, value int) bool
+
+	// Choose applies an assignment to the caller's state
+	Choose func(variable, value int)
+
+	// Unchoose reverts the matching Choose call
+	Unchoose func(variable, value int)
+
+	// MRV picks the unassigned variable with the fewest candidates next
+	// (minimum remaining values) instead of the lowest-numbered one
+	MRV bool
+
+	// MaxSolutions stops the search after this many solutions; 0 means no limit
+	MaxSolutions int
+}
+
+// Solve runs the search and calls onSolution with the assignment (indexed by variable)
+// of every complete solution. The slice is reused between calls, so copy it to keep it.
+// Returning false from onSolution stops the search; a nil onSolution just counts.
+// The hooks are unwound before Solve returns, so the caller's state is restored.
+// It returns the number of solutions found.
+func (b *Backtracker) Solve(onSolution func(assignment []int) bool) int {
+	type frame struct {
+		variable int
+		values   []int
+		next     int
+		chosen   bool
+	}
+
+	assignment := make([]int, b.Variables)
+	assigned := make([]bool, b.Variables)
+	count := 0
+
+	// report records a solution and returns false if the search should stop
+	report := func() bool {
+		count++
+		if onSolution != nil && !onSolution(assignment) {
+			return false
+		}
+		return b.MaxSolutions == 0 || count < b.MaxSolutions
+	}
+
+	if b.Variables == 0 {
+		report()
+		return count
+	}
+
+	stack := []frame{}
+	push := func() {
+		variable, values := b.selectVariable(assigned)
+		stack = append(stack, frame{variable: variable, values: values})
+	}
+	undo := func(top *frame) {
+		if top.chosen {
+			b.Unchoose(top.variable, top.values[top.next-1])
+			assigned[top.variable] = false
+			top.chosen = false
+		}
+	}
+
+	push()
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		undo(top)
+
+		if top.next == len(top.values) {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		value := top.values[top.next]
+		top.next++
+		if b.Accept != nil && !b.Accept(top.variable, value) {
+			continue
+		}
+
+		b.Choose(top.variable, value)
+		top.chosen = true
+		assigned[top.variable] = true
+		assignment[top.variable] = value
+
+		if len(stack) < b.Variables {
+			push()
+			continue
+		}
+		if !report() {
+			for i := len(stack) - 1; i >= 0; i-- {
+				undo(&stack[i])
+			}
+			return count
+		}
+	}
+	return count
+}
+
+// Count returns the number of solutions without materializing them
+func (b *Backtracker) Count() int {
+	return b.Solve(nil)
+}
+
+// selectVariable picks the next unassigned variable and its candidate values
+func (b *Backtracker) selectVariable(assigned []bool) (int, []int) {
+	best := -1
+	var bestValues []int
+	for variable, done := range assigned {
+		if done {
+			continue
+		}
+		if !b.MRV {
+			return variable, b.Candidates(variable)
+		}
+		values := b.Candidates(variable)
+		if best == -1 || len(values) < len(bestValues) {
+			best, bestValues = variable, values
+			if len(values) == 0 {
+				break // dead end: fail fast
+			}
+		}
+	}
+	return best, bestValues
+}
@@ -0,0 +1,133 @@
+package dfs
+
+import (
+	"reflect"
+	"testing"
+)
+
+// newPermutationSolver enumerates permutations of 0..n-1, recording hook calls
+func newPermutationSolver(n int, log *[]string) (*Backtracker, []bool) {
+	used := make([]bool, n)
+	return &Backtracker{
+		Variables: n,
+		Candidates: func(position int) []int {
+			free := []int{}
+			for v := 0; v < n; v++ {
+				if !used[v] {
+					free = append(free, v)
+				}
+			}
+			return free
+		},
+		Choose: func(position, v int) {
+			used[v] = true
+			*log = append(*log, "choose")
+		},
+		Unchoose: func(position, v int) {
+			used[v] = false
+			*log = append(*log, "unchoose")
+		},
+	}, used
+}
+
+func TestBacktrackerEnumerates(t *testing.T) {
+	log := []string{}
+	solver, _ := newPermutationSolver(3, &log)
+
+	solutions := [][]int{}
+	count := solver.Solve(func(assignment []int) bool {
+		solutions = append(solutions, append([]int(nil), assignment...))
+		return true
+	})
+
+	expected := [][]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	if count != 6 || !reflect.DeepEqual(solutions, expected) {
+		t.Errorf("Solve failed. Expected %v, got %v (count %d)", expected, solutions, count)
+	}
+
+	// Every choose is matched by an unchoose
+	chooses, unchooses := 0, 0
+	for _, entry := range log {
+		if entry == "choose" {
+			chooses++
+		} else {
+			unchooses++
+		}
+	}
+	if chooses != unchooses {
+		t.Errorf("Hooks should balance, got %d chooses and %d unchooses", chooses, unchooses)
+	}
+}
+
+func TestBacktrackerLimitsRestoreState(t *testing.T) {
+	log := []string{}
+	solver, used := newPermutationSolver(4, &log)
+	solver.MaxSolutions = 5
+
+	if count := solver.Count(); count != 5 {
+		t.Errorf("MaxSolutions should stop after 5 solutions, got %d", count)
+	}
+	for v, u := range used {
+		if u {
+			t.Errorf("Value %d still marked used after Solve returned early", v)
+		}
+	}
+
+	solver.MaxSolutions = 0
+	stopped := solver.Solve(func([]int) bool { return false })
+	if stopped != 1 {
+		t.Errorf("Returning false should stop after the first solution, got %d", stopped)
+	}
+	for v, u := range used {
+		if u {
+			t.Errorf("Value %d still marked used after the callback stopped the search", v)
+		}
+	}
+
+	if count := solver.Count(); count != 24 {
+		t.Errorf("Count should find all 24 permutations, got %d", count)
+	}
+}
+
+func TestBacktrackerMRV(t *testing.T) {
+	// Variable 2 has a single candidate, so MRV assigns it first
+	order := []int{}
+	solver := &Backtracker{
+		Variables: 3,
+		MRV:       true,
+		Candidates: func(variable int) []int {
+			if variable == 2 {
+				return []int{7}
+			}
+			return []int{1, 2}
+		},
+		Choose:   func(variable, value int) { order = append(order, variable) },
+		Unchoose: func(variable, value int) {},
+	}
+
+	if count := solver.Count(); count != 4 {
+		t.Errorf("Expected 4 solutions, got %d", count)
+	}
+	if order[0] != 2 {
+		t.Errorf("MRV should assign variable 2 first, got order %v", order)
+	}
+}
+
+func TestBacktrackerAcceptPrunes(t *testing.T) {
+	// Binary strings of length 4 without two adjacent ones
+	bits := make([]int, 4)
+	solver := &Backtracker{
+		Variables:  4,
+		Candidates: func(int) []int { return []int{0, 1} },
+		Accept: func(i, v int) bool {
+			return v == 0 || i == 0 || bits[i-1] == 0
+		},
+		Choose:   func(i, v int) { bits[i] = v },
+		Unchoose: func(i, v int) { bits[i] = 0 },
+	}
+
+	// Fibonacci: F(6) = 8
+	if count := solver.Count(); count != 8 {
+		t.Errorf("Expected 8 strings without adjacent ones, got %d", count)
+	}
+}
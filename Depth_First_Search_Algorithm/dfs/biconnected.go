@@ -0,0 +1,154 @@
+package dfs
+
+import "sort"
+
+// === Articulation Points, Bridges and Biconnected Components ===
+
+// Edge represents an edge between two vertices
+type Edge struct {
+	From int
+	To   int
+}
+
+// BiconnectedResult holds the low-link analysis of an undirected graph
+type BiconnectedResult struct {
+	ArticulationPoints []int    // Cut vertices, sorted
+	Bridges            []Edge   // Cut edges with From < To, sorted
+	Components         [][]Edge // Edge partition into biconnected components
+}
+
+// Biconnected runs an iterative low-link DFS over the graph, treated as undirected.
+// Parallel edges are collapsed and self-loops ignored. Edges inside each component
+// are normalized to From < To and sorted.
+func Biconnected(graph map[int][]int) *BiconnectedResult {
+	undirectedGraph := makeUndirected(graph)
+	disc := make(map[int]int)
+	low := make(map[int]int)
+	isCut := make(map[int]bool)
+	edgeStack := []Edge{}
+	result := &BiconnectedResult{
+		ArticulationPoints: []int{},
+		Bridges:            []Edge{},
+		Components:         [][]Edge{},
+	}
+	timer := 0
+
+	type frame struct {
+		node, parent, next, children int
+	}
+
+	for _, root := range sortedVertices(undirectedGraph) {
+		if _, seen := disc[root]; seen {
+			continue
+		}
+
+		disc[root], low[root] = timer, timer
+		timer++
+		callStack := []frame{{node: root, parent: -1}}
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			node := top.node
+			neighbors := undirectedGraph[node]
+
+			if top.next < len(neighbors) {
+				neighbor := neighbors[top.next]
+				top.next++
+				if neighbor == node || (neighbor == top.parent && len(callStack) > 1) {
+					continue
+				}
+
+				if _, seen := disc[neighbor]; !seen {
+					// Tree edge
+					edgeStack = append(edgeStack, Edge{From: node, To: neighbor})
+					top.children++
+					disc[neighbor], low[neighbor] = timer, timer
+					timer++
+					callStack = append(callStack, frame{node: neighbor, parent: node})
+				} else if disc[neighbor] < disc[node] {
+					// Back edge to an ancestor
+					edgeStack = append(edgeStack, Edge{From: node, To: neighbor})
+					if disc[neighbor] < low[node] {
+						low[node] = disc[neighbor]
+					}
+				}
+				continue
+			}
+
+			// All edges explored: return to the parent frame
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) == 0 {
+				if top.children > 1 {
+					isCut[node] = true
+				}
+				continue
+			}
+
+			parent := callStack[len(callStack)-1].node
+			if low[node] < low[parent] {
+				low[parent] = low[node]
+			}
+
+			if low[node] > disc[parent] {
+				result.Bridges = append(result.Bridges, normalizeEdge(Edge{From: parent, To: node}))
+			}
+
+			if low[node] >= disc[parent] {
+				if len(callStack) > 1 {
+					isCut[parent] = true
+				}
+				component := []Edge{}
+				for {
+					edge := edgeStack[len(edgeStack)-1]
+					edgeStack = edgeStack[:len(edgeStack)-1]
+					component = append(component, normalizeEdge(edge))
+					if edge.From == parent && edge.To == node {
+						break
+					}
+				}
+				sortEdges(component)
+				result.Components = append(result.Components, component)
+			}
+		}
+	}
+
+	for node := range isCut {
+		result.ArticulationPoints = append(result.ArticulationPoints, node)
+	}
+	sort.Ints(result.ArticulationPoints)
+	sortEdges(result.Bridges)
+	return result
+}
+
+// ArticulationPoints returns the cut vertices of the graph, treated as undirected
+func ArticulationPoints(graph map[int][]int) []int {
+	return Biconnected(graph).ArticulationPoints
+}
+
+// Bridges returns the cut edges of the graph, treated as undirected
+func Bridges(graph map[int][]int) []Edge {
+	return Biconnected(graph).Bridges
+}
+
+// BiconnectedComponents partitions the edges of the graph into biconnected components
+func BiconnectedComponents(graph map[int][]int) [][]Edge {
+	return Biconnected(graph).Components
+}
+
+// normalizeEdge orders the endpoints of an undirected edge so that From <= To
+func normalizeEdge(e Edge) Edge {
+	if e.From > e.To {
+		return Edge{From: e.To, To: e.From}
+	}
+	return e
+}
+
+// sortEdges sorts edges by From, then To
+func sortEdges(edges []Edge) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		return edges[i].To < edges[j].To
+	})
+}
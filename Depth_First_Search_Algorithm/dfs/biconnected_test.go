@@ -0,0 +1,90 @@
+package dfs
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// Test helper: triangle 1-2-3, bridge 3-4, triangle 4-5-6, pendant 6-7
+func createBiconnectedGraph() map[int][]int {
+	return map[int][]int{
+		1: {2, 3},
+		2: {3},
+		3: {4},
+		4: {5, 6},
+		5: {6},
+		6: {7},
+	}
+}
+
+func TestArticulationPoints(t *testing.T) {
+	result := ArticulationPoints(createBiconnectedGraph())
+	expected := []int{3, 4, 6}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ArticulationPoints failed. Expected %v, got %v", expected, result)
+	}
+
+	// A cycle has no cut vertices
+	cycle := map[int][]int{1: {2}, 2: {3}, 3: {4}, 4: {1}}
+	if result := ArticulationPoints(cycle); len(result) != 0 {
+		t.Errorf("Cycle should have no articulation points, got %v", result)
+	}
+
+	// The centre of a star is a cut vertex even though it is the DFS root
+	star := map[int][]int{1: {2, 3, 4}}
+	if result := ArticulationPoints(star); !reflect.DeepEqual(result, []int{1}) {
+		t.Errorf("Star centre should be the only articulation point, got %v", result)
+	}
+}
+
+func TestBridges(t *testing.T) {
+	result := Bridges(createBiconnectedGraph())
+	expected := []Edge{{From: 3, To: 4}, {From: 6, To: 7}}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Bridges failed. Expected %v, got %v", expected, result)
+	}
+}
+
+func TestBiconnectedComponents(t *testing.T) {
+	components := BiconnectedComponents(createBiconnectedGraph())
+
+	expected := map[string]bool{
+		"[{1 2} {1 3} {2 3}]": true,
+		"[{3 4}]":             true,
+		"[{4 5} {4 6} {5 6}]": true,
+		"[{6 7}]":             true,
+	}
+	if len(components) != len(expected) {
+		t.Fatalf("Expected %d components, got %d: %v", len(expected), len(components), components)
+	}
+
+	// Every edge appears in exactly one component
+	for _, component := range components {
+		key := fmt.Sprint(component)
+		if !expected[key] {
+			t.Errorf("Unexpected biconnected component %s", key)
+		}
+		delete(expected, key)
+	}
+}
+
+func TestBiconnectedDeepPath(t *testing.T) {
+	// The traversal is iterative, so long paths do not grow the goroutine stack
+	n := 200000
+	graph := make(map[int][]int)
+	for i := 1; i < n; i++ {
+		graph[i] = []int{i + 1}
+	}
+
+	result := Biconnected(graph)
+	if len(result.Bridges) != n-1 {
+		t.Errorf("Every edge of a path is a bridge: expected %d, got %d", n-1, len(result.Bridges))
+	}
+	if len(result.ArticulationPoints) != n-2 {
+		t.Errorf("Every inner vertex of a path is a cut vertex: expected %d, got %d",
+			n-2, len(result.ArticulationPoints))
+	}
+}
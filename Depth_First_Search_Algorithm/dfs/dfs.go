@@ -1,7 +1,11 @@
-package main
+// Package dfs implements depth-first search over adjacency-map graphs and binary trees.
+// Traversals return their visit order or call a visitor; nothing is printed.
+package dfs
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"strings"
 )
 
@@ -48,20 +52,39 @@ func (s *Stack) Size() int {
 // === Basic DFS Implementations ===
 
 // 1. Recursive DFS (using implicit stack)
-func DFSRecursive(graph map[int][]int, start int, visited map[int]bool) {
+// Returns the vertices reachable from start in visit order
+func DFSRecursive(graph map[int][]int, start int, visited map[int]bool) []int {
+	order := []int{}
+	DFSVisit(graph, start, visited, func(node int) bool {
+		order = append(order, node)
+		return true
+	})
+	return order
+}
+
+// DFSVisit calls visit for each vertex reachable from start in recursive DFS order.
+// Returning false from visit stops the traversal; DFSVisit then returns false.
+func DFSVisit(graph map[int][]int, start int, visited map[int]bool, visit func(node int) bool) bool {
 	visited[start] = true
-	fmt.Printf("Visited: %d\n", start)
+	if !visit(start) {
+		return false
+	}
 
 	for _, neighbor := range graph[start] {
 		if !visited[neighbor] {
-			DFSRecursive(graph, neighbor, visited)
+			if !DFSVisit(graph, neighbor, visited, visit) {
+				return false
+			}
 		}
 	}
+	return true
 }
 
 // 2. Iterative DFS (using explicit stack)
-func DFSIterative(graph map[int][]int, start int) {
+// Returns the vertices reachable from start in the same order as DFSRecursive
+func DFSIterative(graph map[int][]int, start int) []int {
 	visited := make(map[int]bool)
+	order := []int{}
 	stack := []int{start}
 
 	for len(stack) > 0 {
@@ -70,7 +93,7 @@ func DFSIterative(graph map[int][]int, start int) {
 
 		if !visited[current] {
 			visited[current] = true
-			fmt.Printf("Visited: %d\n", current)
+			order = append(order, current)
 
 			// Add neighbors to stack (in reverse order for consistent traversal)
 			neighbors := graph[current]
@@ -81,11 +104,14 @@ func DFSIterative(graph map[int][]int, start int) {
 			}
 		}
 	}
+	return order
 }
 
 // 3. DFS with custom stack
-func DFSWithCustomStack(graph map[int][]int, start int) {
+// Returns the vertices reachable from start in visit order
+func DFSWithCustomStack(graph map[int][]int, start int) []int {
 	visited := make(map[int]bool)
+	order := []int{}
 	stack := &Stack{}
 	stack.Push(start)
 
@@ -94,7 +120,7 @@ func DFSWithCustomStack(graph map[int][]int, start int) {
 
 		if !visited[current] {
 			visited[current] = true
-			fmt.Printf("Visited: %d\n", current)
+			order = append(order, current)
 
 			neighbors := graph[current]
 			for i := len(neighbors) - 1; i >= 0; i-- {
@@ -104,6 +130,7 @@ func DFSWithCustomStack(graph map[int][]int, start int) {
 			}
 		}
 	}
+	return order
 }
 
 // === Tree Traversals ===
@@ -181,7 +208,7 @@ func FindPath(graph map[int][]int, start, target int) []int {
 	visited := make(map[int]bool)
 	path := []int{}
 
-	if DFSPath(graph, start, target, visited, &path) {
+	if DFSPathIterative(graph, start, target, visited, &path) {
 		return path
 	}
 	return nil
@@ -210,14 +237,16 @@ func DFSPath(graph map[int][]int, current, target int, visited map[int]bool, pat
 
 // Find all paths between two nodes
 func FindAllPaths(graph map[int][]int, start, target int) [][]int {
-	visited := make(map[int]bool)
-	path := []int{}
 	allPaths := [][]int{}
-
-	DFSAllPaths(graph, start, target, visited, path, &allPaths)
+	EnumeratePaths(context.Background(), graph, start, target, PathOptions{}, func(path []int) bool {
+		allPaths = append(allPaths, append([]int(nil), path...))
+		return true
+	})
 	return allPaths
 }
 
+// DFSAllPaths is the recursive reference version of EnumeratePaths, which
+// FindAllPaths uses: it appends every simple path from current to target to allPaths
 func DFSAllPaths(graph map[int][]int, current, target int, visited map[int]bool, path []int, allPaths *[][]int) {
 	visited[current] = true
 	path = append(path, current)
@@ -250,7 +279,7 @@ func HasCycleUndirected(graph map[int][]int) bool {
 
 	for node := range undirectedGraph {
 		if !visited[node] {
-			if DFSCycleUndirected(undirectedGraph, node, -1, visited) {
+			if DFSCycleUndirectedIterative(undirectedGraph, node, -1, visited) {
 				return true
 			}
 		}
@@ -280,7 +309,7 @@ func HasCycleDirected(graph map[int][]int) bool {
 
 	for node := range graph {
 		if !visited[node] {
-			if DFSCycleDirected(graph, node, visited, recStack) {
+			if DFSCycleDirectedIterative(graph, node, visited, recStack) {
 				return true
 			}
 		}
@@ -318,7 +347,7 @@ func CountComponents(graph map[int][]int) int {
 
 	for node := range undirectedGraph {
 		if !visited[node] {
-			DFSComponent(undirectedGraph, node, visited)
+			DFSComponentIterative(undirectedGraph, node, visited)
 			count++
 		}
 	}
@@ -346,7 +375,7 @@ func GetConnectedComponents(graph map[int][]int) [][]int {
 	for node := range undirectedGraph {
 		if !visited[node] {
 			component := []int{}
-			DFSGetComponent(undirectedGraph, node, visited, &component)
+			DFSGetComponentIterative(undirectedGraph, node, visited, &component)
 			components = append(components, component)
 		}
 	}
@@ -372,7 +401,7 @@ func TopologicalSort(graph map[int][]int) []int {
 
 	for node := range graph {
 		if !visited[node] {
-			DFSTopological(graph, node, visited, &stack)
+			DFSTopologicalIterative(graph, node, visited, &stack)
 		}
 	}
 
@@ -437,26 +466,26 @@ func contains(slice []int, item int) bool {
 	return false
 }
 
-// Debug DFS with depth tracking
-func DFSDebug(graph map[int][]int, start, maxDepth int) {
+// Debug DFS with depth tracking, writing an indented trace to w
+func DFSDebug(w io.Writer, graph map[int][]int, start, maxDepth int) {
 	visited := make(map[int]bool)
-	DFSDebugHelper(graph, start, visited, maxDepth, 0)
+	DFSDebugHelperIterative(w, graph, start, visited, maxDepth, 0)
 }
 
-func DFSDebugHelper(graph map[int][]int, node int, visited map[int]bool, maxDepth, currentDepth int) {
+func DFSDebugHelper(w io.Writer, graph map[int][]int, node int, visited map[int]bool, maxDepth, currentDepth int) {
 	indent := strings.Repeat("  ", currentDepth)
-	fmt.Printf("%sVisiting node %d at depth %d\n", indent, node, currentDepth)
+	fmt.Fprintf(w, "%sVisiting node %d at depth %d\n", indent, node, currentDepth)
 
 	visited[node] = true
 
 	if currentDepth >= maxDepth {
-		fmt.Printf("%sMax depth reached\n", indent)
+		fmt.Fprintf(w, "%sMax depth reached\n", indent)
 		return
 	}
 
 	for _, neighbor := range graph[node] {
 		if !visited[neighbor] {
-			DFSDebugHelper(graph, neighbor, visited, maxDepth, currentDepth+1)
+			DFSDebugHelper(w, graph, neighbor, visited, maxDepth, currentDepth+1)
 		}
 	}
 }
@@ -478,7 +507,7 @@ func IsConnected(graph map[int][]int) bool {
 	}
 
 	visited := make(map[int]bool)
-	DFSComponent(undirectedGraph, start, visited)
+	DFSComponentIterative(undirectedGraph, start, visited)
 
 	// Check if all nodes were visited
 	return len(visited) == len(graph)
@@ -498,116 +527,3 @@ func MaxDepth(root *TreeNode) int {
 	}
 	return rightDepth + 1
 }
-
-func main() {
-	fmt.Println("=== Depth-First Search Demonstrations ===")
-
-	// Create sample graph
-	graph := map[int][]int{
-		1: {2, 3},
-		2: {4, 5},
-		3: {6},
-		4: {},
-		5: {},
-		6: {},
-	}
-
-	fmt.Println("Graph:", graph)
-	fmt.Println()
-
-	// 1. Recursive DFS
-	fmt.Println("1. Recursive DFS from node 1:")
-	visited := make(map[int]bool)
-	DFSRecursive(graph, 1, visited)
-	fmt.Println()
-
-	// 2. Iterative DFS
-	fmt.Println("2. Iterative DFS from node 1:")
-	DFSIterative(graph, 1)
-	fmt.Println()
-
-	// 3. Custom Stack DFS
-	fmt.Println("3. DFS with Custom Stack from node 1:")
-	DFSWithCustomStack(graph, 1)
-	fmt.Println()
-
-	// 4. Tree Traversals
-	fmt.Println("4. Tree Traversals:")
-	// Build sample tree:    1
-	//                      / \
-	//                     2   3
-	//                    / \
-	//                   4   5
-	root := &TreeNode{Val: 1}
-	root.Left = &TreeNode{Val: 2}
-	root.Right = &TreeNode{Val: 3}
-	root.Left.Left = &TreeNode{Val: 4}
-	root.Left.Right = &TreeNode{Val: 5}
-
-	fmt.Printf("Pre-order:  %v\n", PreOrder(root))
-	fmt.Printf("In-order:   %v\n", InOrder(root))
-	fmt.Printf("Post-order: %v\n", PostOrder(root))
-	fmt.Printf("Pre-order (Iterative): %v\n", PreOrderIterative(root))
-	fmt.Printf("Max Depth: %d\n", MaxDepth(root))
-	fmt.Println()
-
-	// 5. Path Finding
-	fmt.Println("5. Path Finding:")
-	path := FindPath(graph, 1, 6)
-	fmt.Printf("Path from 1 to 6: %v\n", path)
-
-	allPaths := FindAllPaths(graph, 1, 6)
-	fmt.Printf("All paths from 1 to 6: %v\n", allPaths)
-	fmt.Println()
-
-	// 6. Cycle Detection
-	fmt.Println("6. Cycle Detection:")
-	// Undirected graph with cycle
-	cyclicGraph := map[int][]int{
-		1: {2, 3},
-		2: {1, 3},
-		3: {1, 2},
-	}
-	fmt.Printf("Has cycle (undirected): %t\n", HasCycleUndirected(cyclicGraph))
-
-	// Directed graph with cycle
-	directedCyclic := map[int][]int{
-		1: {2},
-		2: {3},
-		3: {1},
-	}
-	fmt.Printf("Has cycle (directed): %t\n", HasCycleDirected(directedCyclic))
-	fmt.Println()
-
-	// 7. Connected Components
-	fmt.Println("7. Connected Components:")
-	disconnectedGraph := map[int][]int{
-		1: {2},
-		2: {1},
-		3: {4},
-		4: {3},
-		5: {},
-	}
-	fmt.Printf("Number of components: %d\n", CountComponents(disconnectedGraph))
-	fmt.Printf("Components: %v\n", GetConnectedComponents(disconnectedGraph))
-	fmt.Printf("Is connected: %t\n", IsConnected(graph))
-	fmt.Println()
-
-	// 8. Topological Sort
-	fmt.Println("8. Topological Sort:")
-	dag := map[int][]int{
-		5: {2, 0},
-		4: {0, 1},
-		2: {3},
-		3: {1},
-		0: {},
-		1: {},
-	}
-	topoSort := TopologicalSort(dag)
-	fmt.Printf("Topological order: %v\n", topoSort)
-	fmt.Println()
-
-	// 9. Debug DFS
-	fmt.Println("9. Debug DFS (with depth limit):")
-	DFSDebug(graph, 1, 3)
-}
@@ -1,7 +1,9 @@
-package main
+package dfs
 
 import (
+	"bytes"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -79,6 +81,58 @@ func TestStack(t *testing.T) {
 	}
 }
 
+// Test Graph Traversals
+func TestDFSTraversalOrders(t *testing.T) {
+	graph := createTestGraph()
+	expected := []int{1, 2, 4, 5, 3, 6}
+
+	if result := DFSRecursive(graph, 1, make(map[int]bool)); !reflect.DeepEqual(result, expected) {
+		t.Errorf("DFSRecursive failed. Expected %v, got %v", expected, result)
+	}
+
+	if result := DFSIterative(graph, 1); !reflect.DeepEqual(result, expected) {
+		t.Errorf("DFSIterative failed. Expected %v, got %v", expected, result)
+	}
+
+	if result := DFSWithCustomStack(graph, 1); !reflect.DeepEqual(result, expected) {
+		t.Errorf("DFSWithCustomStack failed. Expected %v, got %v", expected, result)
+	}
+}
+
+func TestDFSVisitStops(t *testing.T) {
+	graph := createTestGraph()
+	visited := []int{}
+
+	completed := DFSVisit(graph, 1, make(map[int]bool), func(node int) bool {
+		visited = append(visited, node)
+		return node != 4
+	})
+
+	if completed {
+		t.Error("DFSVisit should report that the visitor stopped the traversal")
+	}
+	if !reflect.DeepEqual(visited, []int{1, 2, 4}) {
+		t.Errorf("DFSVisit should stop at node 4, visited %v", visited)
+	}
+}
+
+func TestDFSDebug(t *testing.T) {
+	var buf bytes.Buffer
+	DFSDebug(&buf, createTestGraph(), 1, 1)
+
+	expected := []string{
+		"Visiting node 1 at depth 0",
+		"  Visiting node 2 at depth 1",
+		"  Max depth reached",
+		"  Visiting node 3 at depth 1",
+		"  Max depth reached",
+	}
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("DFSDebug trace = %q; want %q", lines, expected)
+	}
+}
+
 // Test Tree Traversals
 func TestPreOrder(t *testing.T) {
 	root := createTestTree()
@@ -0,0 +1,133 @@
+package dfs
+
+import "sort"
+
+// === Dominator Trees ===
+
+// Dominators describes the dominance relation of a rooted directed graph.
+// Only vertices reachable from Root are included.
+type Dominators struct {
+	Root     int
+	IDom     map[int]int   // Immediate dominator of every reachable vertex except Root
+	Tree     map[int][]int // Dominator tree: children of each vertex, sorted
+	Frontier map[int][]int // Dominance frontier of each vertex, sorted
+}
+
+// ComputeDominators finds immediate dominators with the iterative algorithm of
+// Cooper, Harvey and Kennedy, then builds the dominator tree and dominance frontiers.
+func ComputeDominators(graph map[int][]int, root int) *Dominators {
+	// Number vertices in postorder; iterate in reverse postorder
+	postorder := postOrderFrom(graph, root, make(map[int]bool))
+	number := make(map[int]int, len(postorder))
+	for i, node := range postorder {
+		number[node] = i
+	}
+
+	preds := make(map[int][]int, len(postorder))
+	for _, node := range postorder {
+		for _, succ := range graph[node] {
+			if !contains(preds[succ], node) {
+				preds[succ] = append(preds[succ], node)
+			}
+		}
+	}
+
+	idom := map[int]int{root: root}
+	intersect := func(a, b int) int {
+		for a != b {
+			for number[a] < number[b] {
+				a = idom[a]
+			}
+			for number[b] < number[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for i := len(postorder) - 2; i >= 0; i-- {
+			node := postorder[i]
+			newIDom := -1
+			found := false
+			for _, p := range preds[node] {
+				if _, processed := idom[p]; !processed {
+					continue
+				}
+				if !found {
+					newIDom, found = p, true
+				} else {
+					newIDom = intersect(p, newIDom)
+				}
+			}
+			if current, ok := idom[node]; !ok || current != newIDom {
+				idom[node] = newIDom
+				changed = true
+			}
+		}
+	}
+
+	d := &Dominators{
+		Root:     root,
+		IDom:     make(map[int]int, len(postorder)),
+		Tree:     make(map[int][]int, len(postorder)),
+		Frontier: make(map[int][]int, len(postorder)),
+	}
+	for _, node := range postorder {
+		d.Tree[node] = []int{}
+		d.Frontier[node] = []int{}
+	}
+	for node, dom := range idom {
+		if node != root {
+			d.IDom[node] = dom
+			d.Tree[dom] = append(d.Tree[dom], node)
+		}
+	}
+
+	// A join point belongs to the frontier of every vertex on the way up from its
+	// predecessors to its immediate dominator
+	for _, node := range postorder {
+		if len(preds[node]) < 2 {
+			continue
+		}
+		for _, p := range preds[node] {
+			for runner := p; runner != idom[node]; runner = idom[runner] {
+				if !contains(d.Frontier[runner], node) {
+					d.Frontier[runner] = append(d.Frontier[runner], node)
+				}
+			}
+		}
+	}
+
+	for _, children := range d.Tree {
+		sort.Ints(children)
+	}
+	for _, frontier := range d.Frontier {
+		sort.Ints(frontier)
+	}
+	return d
+}
+
+// ComputePostDominators finds post-dominators by running ComputeDominators on the
+// reversed graph from exit. Graphs with several exits should first add a single
+// virtual exit vertex with edges from each of them.
+func ComputePostDominators(graph map[int][]int, exit int) *Dominators {
+	return ComputeDominators(Transpose(graph), exit)
+}
+
+// Dominates reports whether every path from Root to b passes through a.
+// Every reachable vertex dominates itself.
+func (d *Dominators) Dominates(a, b int) bool {
+	if _, ok := d.Tree[b]; !ok {
+		return false
+	}
+	for node := b; ; node = d.IDom[node] {
+		if node == a {
+			return true
+		}
+		if node == d.Root {
+			return false
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package dfs
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// Test helper: control-flow graph with a loop 2 -> ... -> 5 -> 2 and an
+// unreachable block 7
+func createFlowGraph() map[int][]int {
+	return map[int][]int{
+		1: {2},
+		2: {3, 4},
+		3: {5},
+		4: {5},
+		5: {6, 2},
+		6: {},
+		7: {5},
+	}
+}
+
+func TestComputeDominators(t *testing.T) {
+	d := ComputeDominators(createFlowGraph(), 1)
+
+	expectedIDom := map[int]int{2: 1, 3: 2, 4: 2, 5: 2, 6: 5}
+	if fmt.Sprint(d.IDom) != fmt.Sprint(expectedIDom) {
+		t.Errorf("IDom = %v; want %v", d.IDom, expectedIDom)
+	}
+
+	expectedTree := map[int][]int{1: {2}, 2: {3, 4, 5}, 3: {}, 4: {}, 5: {6}, 6: {}}
+	if fmt.Sprint(d.Tree) != fmt.Sprint(expectedTree) {
+		t.Errorf("Tree = %v; want %v", d.Tree, expectedTree)
+	}
+
+	expectedFrontier := map[int][]int{1: {}, 2: {2}, 3: {5}, 4: {5}, 5: {2}, 6: {}}
+	if fmt.Sprint(d.Frontier) != fmt.Sprint(expectedFrontier) {
+		t.Errorf("Frontier = %v; want %v", d.Frontier, expectedFrontier)
+	}
+
+	if !d.Dominates(2, 6) || !d.Dominates(6, 6) || d.Dominates(3, 5) || d.Dominates(1, 7) {
+		t.Error("Dominates returned wrong results")
+	}
+}
+
+func TestComputePostDominators(t *testing.T) {
+	d := ComputePostDominators(createFlowGraph(), 6)
+
+	expected := map[int]int{1: 2, 2: 5, 3: 5, 4: 5, 5: 6, 7: 5}
+	if fmt.Sprint(d.IDom) != fmt.Sprint(expected) {
+		t.Errorf("post-dominator IDom = %v; want %v", d.IDom, expected)
+	}
+	if !d.Dominates(5, 1) || d.Dominates(3, 2) {
+		t.Error("post-dominance queries returned wrong results")
+	}
+}
+
+func TestDominatorsMatchBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	for trial := 0; trial < 50; trial++ {
+		n := 2 + rng.Intn(12)
+		graph := make(map[int][]int, n)
+		for u := 0; u < n; u++ {
+			graph[u] = []int{}
+			for v := 0; v < n; v++ {
+				if rng.Intn(4) == 0 {
+					graph[u] = append(graph[u], v)
+				}
+			}
+		}
+
+		d := ComputeDominators(graph, 0)
+		reachable := make(map[int]bool)
+		DFSRecursive(graph, 0, reachable)
+
+		// a strictly dominates b if b becomes unreachable once a is removed
+		for a := 0; a < n; a++ {
+			without := make(map[int]bool)
+			if a != 0 {
+				without[a] = true
+				DFSRecursive(graph, 0, without)
+			}
+			for b := range reachable {
+				want := a == b || (a == 0 && reachable[a]) || (a != 0 && reachable[a] && !without[b])
+				if got := d.Dominates(a, b); got != want {
+					t.Fatalf("trial %d: Dominates(%d, %d) = %v; want %v in %v", trial, a, b, got, want, graph)
+				}
+			}
+		}
+	}
+}
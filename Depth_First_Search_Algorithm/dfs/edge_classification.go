@@ -0,0 +1,135 @@
+package dfs
+
+// === DFS Forest and Edge Classification ===
+
+// EdgeKind classifies a directed edge relative to a DFS forest
+type EdgeKind int
+
+const (
+	TreeEdge    EdgeKind = iota // Edge to a newly discovered vertex
+	BackEdge                    // Edge to an ancestor still on the stack (closes a cycle)
+	ForwardEdge                 // Non-tree edge to a finished descendant
+	CrossEdge                   // Edge to a finished vertex in another subtree or tree
+)
+
+// String returns the name of the edge kind
+func (k EdgeKind) String() string {
+	switch k {
+	case TreeEdge:
+		return "tree"
+	case BackEdge:
+		return "back"
+	case ForwardEdge:
+		return "forward"
+	case CrossEdge:
+		return "cross"
+	}
+	return "unknown"
+}
+
+// ClassifiedEdge is an edge together with its DFS classification
+type ClassifiedEdge struct {
+	Edge
+	Kind EdgeKind
+}
+
+// DFSForest records a full depth-first traversal of a directed graph
+type DFSForest struct {
+	Discovery map[int]int      // Time each vertex was first reached
+	Finish    map[int]int      // Time each vertex's edges were exhausted
+	Parent    map[int]int      // Tree parent of each vertex, -1 for roots
+	Edges     []ClassifiedEdge // Every edge in the order it was examined
+	BackEdges []Edge           // The back edges; the graph is acyclic iff this is empty
+}
+
+// BuildDFSForest runs an iterative DFS over every vertex, starting new trees from
+// unvisited vertices in ascending order. A single clock is shared by discovery and
+// finish events, so times run from 1 to 2V.
+func BuildDFSForest(graph map[int][]int) *DFSForest {
+	forest := &DFSForest{
+		Discovery: make(map[int]int),
+		Finish:    make(map[int]int),
+		Parent:    make(map[int]int),
+		Edges:     []ClassifiedEdge{},
+		BackEdges: []Edge{},
+	}
+	clock := 0
+
+	type frame struct {
+		node, next int
+	}
+
+	for _, root := range sortedVertices(graph) {
+		if _, seen := forest.Discovery[root]; seen {
+			continue
+		}
+
+		clock++
+		forest.Discovery[root] = clock
+		forest.Parent[root] = -1
+		callStack := []frame{{node: root}}
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			node := top.node
+
+			if top.next == len(graph[node]) {
+				clock++
+				forest.Finish[node] = clock
+				callStack = callStack[:len(callStack)-1]
+				continue
+			}
+
+			neighbor := graph[node][top.next]
+			top.next++
+			edge := Edge{From: node, To: neighbor}
+
+			_, discovered := forest.Discovery[neighbor]
+			_, finished := forest.Finish[neighbor]
+			var kind EdgeKind
+			switch {
+			case !discovered:
+				kind = TreeEdge
+				clock++
+				forest.Discovery[neighbor] = clock
+				forest.Parent[neighbor] = node
+				callStack = append(callStack, frame{node: neighbor})
+			case !finished:
+				kind = BackEdge
+				forest.BackEdges = append(forest.BackEdges, edge)
+			case forest.Discovery[node] < forest.Discovery[neighbor]:
+				kind = ForwardEdge
+			default:
+				kind = CrossEdge
+			}
+			forest.Edges = append(forest.Edges, ClassifiedEdge{Edge: edge, Kind: kind})
+		}
+	}
+	return forest
+}
+
+// Cycle returns the cycle closed by a back edge u -> v as the tree path from v down
+// to u followed by v again. It returns nil if the edge is not a back edge of the forest.
+func (f *DFSForest) Cycle(back Edge) []int {
+	if !f.IsAncestor(back.To, back.From) {
+		return nil
+	}
+
+	cycle := []int{back.To}
+	for node := back.From; node != back.To; node = f.Parent[node] {
+		cycle = append(cycle, node)
+	}
+	reverseInts(cycle[1:])
+	return append(cycle, back.To)
+}
+
+// IsAncestor reports whether u is an ancestor of v (or v itself) in the DFS forest,
+// using the parenthesis property of discovery and finish times
+func (f *DFSForest) IsAncestor(u, v int) bool {
+	du, ok1 := f.Discovery[u]
+	dv, ok2 := f.Discovery[v]
+	if !ok1 || !ok2 {
+		return false
+	}
+	return du <= dv && f.Finish[v] <= f.Finish[u]
+}
@@ -0,0 +1,90 @@
+package dfs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildDFSForest(t *testing.T) {
+	graph := map[int][]int{
+		1: {2, 3},
+		2: {3, 1},
+		3: {},
+		4: {3},
+	}
+
+	forest := BuildDFSForest(graph)
+
+	expectedDiscovery := map[int]int{1: 1, 2: 2, 3: 3, 4: 7}
+	expectedFinish := map[int]int{1: 6, 2: 5, 3: 4, 4: 8}
+	expectedParent := map[int]int{1: -1, 2: 1, 3: 2, 4: -1}
+
+	if !reflect.DeepEqual(forest.Discovery, expectedDiscovery) {
+		t.Errorf("Discovery times failed. Expected %v, got %v", expectedDiscovery, forest.Discovery)
+	}
+	if !reflect.DeepEqual(forest.Finish, expectedFinish) {
+		t.Errorf("Finish times failed. Expected %v, got %v", expectedFinish, forest.Finish)
+	}
+	if !reflect.DeepEqual(forest.Parent, expectedParent) {
+		t.Errorf("Parent map failed. Expected %v, got %v", expectedParent, forest.Parent)
+	}
+
+	expectedEdges := []ClassifiedEdge{
+		{Edge{1, 2}, TreeEdge},
+		{Edge{2, 3}, TreeEdge},
+		{Edge{2, 1}, BackEdge},
+		{Edge{1, 3}, ForwardEdge},
+		{Edge{4, 3}, CrossEdge},
+	}
+	if !reflect.DeepEqual(forest.Edges, expectedEdges) {
+		t.Errorf("Edge classification failed. Expected %v, got %v", expectedEdges, forest.Edges)
+	}
+	if !reflect.DeepEqual(forest.BackEdges, []Edge{{2, 1}}) {
+		t.Errorf("Back edges failed. Expected [{2 1}], got %v", forest.BackEdges)
+	}
+}
+
+func TestDFSForestCycle(t *testing.T) {
+	graph := map[int][]int{
+		1: {2},
+		2: {3},
+		3: {4},
+		4: {2},
+	}
+
+	forest := BuildDFSForest(graph)
+	if len(forest.BackEdges) != 1 {
+		t.Fatalf("Expected one back edge, got %v", forest.BackEdges)
+	}
+
+	cycle := forest.Cycle(forest.BackEdges[0])
+	expected := []int{2, 3, 4, 2}
+	if !reflect.DeepEqual(cycle, expected) {
+		t.Errorf("Cycle failed. Expected %v, got %v", expected, cycle)
+	}
+
+	// A tree edge does not close a cycle
+	if cycle := forest.Cycle(Edge{From: 1, To: 2}); cycle != nil {
+		t.Errorf("Cycle of a tree edge should be nil, got %v", cycle)
+	}
+
+	// Self-loops are back edges closing a one-vertex cycle
+	selfLoop := BuildDFSForest(map[int][]int{1: {1}})
+	if cycle := selfLoop.Cycle(Edge{1, 1}); !reflect.DeepEqual(cycle, []int{1, 1}) {
+		t.Errorf("Self-loop cycle failed. Expected [1 1], got %v", cycle)
+	}
+}
+
+func TestDFSForestAcyclic(t *testing.T) {
+	forest := BuildDFSForest(createTestGraph())
+
+	if len(forest.BackEdges) != 0 {
+		t.Errorf("Tree should have no back edges, got %v", forest.BackEdges)
+	}
+	if !forest.IsAncestor(1, 6) || forest.IsAncestor(2, 6) {
+		t.Error("IsAncestor should follow the DFS tree")
+	}
+	if TreeEdge.String() != "tree" || CrossEdge.String() != "cross" {
+		t.Error("EdgeKind names are wrong")
+	}
+}
@@ -0,0 +1,288 @@
+package dfs
+
+import "fmt"
+
+// === Eulerian Paths and Circuits ===
+
+// EulerianKind describes which kind of Eulerian walk a graph admits
+type EulerianKind int
+
+const (
+	NotEulerian     EulerianKind = iota // No walk uses every edge exactly once
+	EulerianPath                        // A walk with distinct endpoints uses every edge once
+	EulerianCircuit                     // A closed walk uses every edge once
+)
+
+// ClassifyEulerianDirected checks the degree and connectivity conditions for a directed graph.
+// When the graph is not Eulerian the error explains which condition fails.
+func ClassifyEulerianDirected(graph map[int][]int) (EulerianKind, error) {
+	kind, _, err := classifyDirected(graph)
+	return kind, err
+}
+
+// ClassifyEulerianUndirected checks the degree and connectivity conditions for an undirected graph.
+// Every edge must be listed from both endpoints: parallel edges are listed once per copy
+// and a self-loop appears twice in its vertex's list.
+func ClassifyEulerianUndirected(graph map[int][]int) (EulerianKind, error) {
+	kind, _, err := classifyUndirected(graph)
+	return kind, err
+}
+
+// EulerianPathDirected builds a walk that uses every directed edge exactly once.
+// If the graph has an Eulerian circuit, the circuit is returned.
+func EulerianPathDirected(graph map[int][]int) ([]int, error) {
+	kind, start, err := classifyDirected(graph)
+	if kind == NotEulerian {
+		return nil, err
+	}
+	return hierholzerDirected(graph, start), nil
+}
+
+// EulerianCircuitDirected builds a closed walk that uses every directed edge exactly once
+func EulerianCircuitDirected(graph map[int][]int) ([]int, error) {
+	kind, start, err := classifyDirected(graph)
+	if kind == NotEulerian {
+		return nil, err
+	}
+	if kind != EulerianCircuit {
+		return nil, fmt.Errorf("no Eulerian circuit: vertex %d has out-degree greater than in-degree", start)
+	}
+	return hierholzerDirected(graph, start), nil
+}
+
+// EulerianPathUndirected builds a walk that uses every undirected edge exactly once.
+// If the graph has an Eulerian circuit, the circuit is returned.
+func EulerianPathUndirected(graph map[int][]int) ([]int, error) {
+	kind, start, err := classifyUndirected(graph)
+	if kind == NotEulerian {
+		return nil, err
+	}
+	return hierholzerUndirected(graph, start), nil
+}
+
+// EulerianCircuitUndirected builds a closed walk that uses every undirected edge exactly once
+func EulerianCircuitUndirected(graph map[int][]int) ([]int, error) {
+	kind, start, err := classifyUndirected(graph)
+	if kind == NotEulerian {
+		return nil, err
+	}
+	if kind != EulerianCircuit {
+		return nil, fmt.Errorf("no Eulerian circuit: vertex %d has odd degree", start)
+	}
+	return hierholzerUndirected(graph, start), nil
+}
+
+// classifyDirected returns the Eulerian kind and the vertex a walk must start from
+func classifyDirected(graph map[int][]int) (EulerianKind, int, error) {
+	vertices := sortedVertices(graph)
+	inDegree := make(map[int]int)
+	for _, neighbors := range graph {
+		for _, neighbor := range neighbors {
+			inDegree[neighbor]++
+		}
+	}
+
+	start, end := -1, -1
+	first := -1
+	for _, node := range vertices {
+		out, in := len(graph[node]), inDegree[node]
+		if first == -1 && out > 0 {
+			first = node
+		}
+		switch out - in {
+		case 0:
+		case 1:
+			if start != -1 {
+				return NotEulerian, -1, fmt.Errorf(
+					"no Eulerian path: vertices %d and %d both have out-degree - in-degree = 1", start, node)
+			}
+			start = node
+		case -1:
+			if end != -1 {
+				return NotEulerian, -1, fmt.Errorf(
+					"no Eulerian path: vertices %d and %d both have in-degree - out-degree = 1", end, node)
+			}
+			end = node
+		default:
+			return NotEulerian, -1, fmt.Errorf(
+				"no Eulerian path: vertex %d has out-degree %d and in-degree %d, which differ by more than 1",
+				node, out, in)
+		}
+	}
+
+	if first == -1 {
+		return EulerianCircuit, -1, nil // no edges
+	}
+	if err := checkEdgesConnected(graph, first); err != nil {
+		return NotEulerian, -1, err
+	}
+	if start == -1 {
+		return EulerianCircuit, first, nil
+	}
+	return EulerianPath, start, nil
+}
+
+// classifyUndirected returns the Eulerian kind and the vertex a walk must start from
+func classifyUndirected(graph map[int][]int) (EulerianKind, int, error) {
+	if err := checkSymmetric(graph); err != nil {
+		return NotEulerian, -1, err
+	}
+
+	vertices := sortedVertices(graph)
+	odd := []int{}
+	first := -1
+	for _, node := range vertices {
+		degree := len(graph[node])
+		if first == -1 && degree > 0 {
+			first = node
+		}
+		if degree%2 == 1 {
+			odd = append(odd, node)
+		}
+	}
+
+	if len(odd) > 2 {
+		return NotEulerian, -1, fmt.Errorf(
+			"no Eulerian path: %d vertices have odd degree (%v), at most 2 are allowed", len(odd), odd)
+	}
+	if first == -1 {
+		return EulerianCircuit, -1, nil // no edges
+	}
+	if err := checkEdgesConnected(graph, first); err != nil {
+		return NotEulerian, -1, err
+	}
+	if len(odd) == 0 {
+		return EulerianCircuit, first, nil
+	}
+	return EulerianPath, odd[0], nil
+}
+
+// checkSymmetric verifies that every undirected edge is listed from both endpoints
+func checkSymmetric(graph map[int][]int) error {
+	count := make(map[Edge]int)
+	for node, neighbors := range graph {
+		for _, neighbor := range neighbors {
+			count[Edge{From: node, To: neighbor}]++
+		}
+	}
+	for edge, n := range count {
+		if edge.From == edge.To {
+			if n%2 != 0 {
+				return fmt.Errorf("invalid undirected graph: self-loop at %d must be listed twice", edge.From)
+			}
+		} else if count[Edge{From: edge.To, To: edge.From}] != n {
+			return fmt.Errorf("invalid undirected graph: edge %d-%d is not listed from both endpoints",
+				edge.From, edge.To)
+		}
+	}
+	return nil
+}
+
+// checkEdgesConnected verifies that every vertex with an edge is weakly connected to start
+func checkEdgesConnected(graph map[int][]int, start int) error {
+	undirectedGraph := makeUndirected(graph)
+	visited := make(map[int]bool)
+	stack := []int{start}
+	visited[start] = true
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, neighbor := range undirectedGraph[node] {
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				stack = append(stack, neighbor)
+			}
+		}
+	}
+
+	for _, node := range sortedVertices(graph) {
+		if len(undirectedGraph[node]) > 0 && !visited[node] {
+			return fmt.Errorf("no Eulerian path: edges at vertices %d and %d are in different components",
+				start, node)
+		}
+	}
+	return nil
+}
+
+// hierholzerDirected follows unused edges from start, splicing in sub-tours as it backtracks
+func hierholzerDirected(graph map[int][]int, start int) []int {
+	if start == -1 {
+		return []int{}
+	}
+	next := make(map[int]int)
+	path := []int{}
+	stack := []int{start}
+
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		if next[node] < len(graph[node]) {
+			stack = append(stack, graph[node][next[node]])
+			next[node]++
+		} else {
+			path = append(path, node)
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	reverseInts(path)
+	return path
+}
+
+// hierholzerUndirected is Hierholzer's algorithm with each undirected edge used once
+func hierholzerUndirected(graph map[int][]int, start int) []int {
+	if start == -1 {
+		return []int{}
+	}
+
+	// Pair up the two listings of every edge under a shared id
+	type incidence struct {
+		to, id int
+	}
+	incident := make(map[int][]incidence)
+	pending := make(map[Edge][]int)
+	edges := 0
+	for _, node := range sortedVertices(graph) {
+		for _, neighbor := range graph[node] {
+			reverse := Edge{From: neighbor, To: node}
+			if ids := pending[reverse]; len(ids) > 0 {
+				pending[reverse] = ids[1:]
+				incident[node] = append(incident[node], incidence{to: neighbor, id: ids[0]})
+				continue
+			}
+			pending[Edge{From: node, To: neighbor}] = append(pending[Edge{From: node, To: neighbor}], edges)
+			incident[node] = append(incident[node], incidence{to: neighbor, id: edges})
+			edges++
+		}
+	}
+
+	used := make([]bool, edges)
+	next := make(map[int]int)
+	path := []int{}
+	stack := []int{start}
+
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		list := incident[node]
+		for next[node] < len(list) && used[list[next[node]].id] {
+			next[node]++
+		}
+		if next[node] < len(list) {
+			edge := list[next[node]]
+			used[edge.id] = true
+			stack = append(stack, edge.to)
+		} else {
+			path = append(path, node)
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	reverseInts(path)
+	return path
+}
+
+// reverseInts reverses a slice in place
+func reverseInts(s []int) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
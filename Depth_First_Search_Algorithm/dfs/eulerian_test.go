@@ -0,0 +1,182 @@
+package dfs
+
+import (
+	"strings"
+	"testing"
+)
+
+// checkEulerianWalk verifies that walk uses every directed edge of graph exactly once
+func checkEulerianWalk(t *testing.T, graph map[int][]int, walk []int, undirected bool) {
+	t.Helper()
+	remaining := make(map[Edge]int)
+	total := 0
+	for node, neighbors := range graph {
+		for _, neighbor := range neighbors {
+			remaining[Edge{From: node, To: neighbor}]++
+			total++
+		}
+	}
+	if undirected {
+		total /= 2
+	}
+
+	if len(walk) != total+1 {
+		t.Fatalf("Walk %v should have %d vertices, got %d", walk, total+1, len(walk))
+	}
+	for i := 1; i < len(walk); i++ {
+		edge := Edge{From: walk[i-1], To: walk[i]}
+		if remaining[edge] == 0 {
+			t.Fatalf("Walk %v uses edge %v more often than it exists", walk, edge)
+		}
+		remaining[edge]--
+		if undirected {
+			remaining[Edge{From: edge.To, To: edge.From}]--
+		}
+	}
+}
+
+func TestEulerianDirected(t *testing.T) {
+	// Path from 1 to 4 with a detour through the cycle 2 -> 3 -> 2
+	graph := map[int][]int{
+		1: {2},
+		2: {3, 4},
+		3: {2},
+	}
+
+	kind, err := ClassifyEulerianDirected(graph)
+	if kind != EulerianPath || err != nil {
+		t.Errorf("ClassifyEulerianDirected = %v, %v; want EulerianPath", kind, err)
+	}
+
+	path, err := EulerianPathDirected(graph)
+	if err != nil {
+		t.Fatalf("EulerianPathDirected failed: %v", err)
+	}
+	checkEulerianWalk(t, graph, path, false)
+	if path[0] != 1 || path[len(path)-1] != 4 {
+		t.Errorf("Eulerian path should run from 1 to 4, got %v", path)
+	}
+
+	if _, err := EulerianCircuitDirected(graph); err == nil {
+		t.Error("EulerianCircuitDirected should fail when degrees are unbalanced")
+	}
+}
+
+func TestEulerianCircuitDirectedMultiEdges(t *testing.T) {
+	// Two parallel edges each way between 1 and 2, plus a self-loop
+	graph := map[int][]int{
+		1: {2, 2, 1},
+		2: {1, 1},
+	}
+
+	circuit, err := EulerianCircuitDirected(graph)
+	if err != nil {
+		t.Fatalf("EulerianCircuitDirected failed: %v", err)
+	}
+	checkEulerianWalk(t, graph, circuit, false)
+	if circuit[0] != circuit[len(circuit)-1] {
+		t.Errorf("Circuit should be closed, got %v", circuit)
+	}
+}
+
+func TestEulerianUndirected(t *testing.T) {
+	// Königsberg-style multigraph with two odd vertices (1 and 3)
+	graph := map[int][]int{
+		1: {2, 2, 3},
+		2: {1, 1, 3, 4},
+		3: {2, 1, 4},
+		4: {2, 3},
+	}
+
+	path, err := EulerianPathUndirected(graph)
+	if err != nil {
+		t.Fatalf("EulerianPathUndirected failed: %v", err)
+	}
+	checkEulerianWalk(t, graph, path, true)
+	if path[0] != 1 || path[len(path)-1] != 3 {
+		t.Errorf("Eulerian path should run between the odd vertices 1 and 3, got %v", path)
+	}
+
+	// A square with a self-loop has a circuit
+	square := map[int][]int{
+		1: {2, 4, 1, 1},
+		2: {1, 3},
+		3: {2, 4},
+		4: {3, 1},
+	}
+	circuit, err := EulerianCircuitUndirected(square)
+	if err != nil {
+		t.Fatalf("EulerianCircuitUndirected failed: %v", err)
+	}
+	checkEulerianWalk(t, square, circuit, true)
+}
+
+func TestEulerianFailureReasons(t *testing.T) {
+	tests := []struct {
+		name   string
+		check  func() (EulerianKind, error)
+		reason string
+	}{
+		{"directed degree gap", func() (EulerianKind, error) {
+			return ClassifyEulerianDirected(map[int][]int{1: {2, 3, 4}})
+		}, "vertex 1 has out-degree 3 and in-degree 0"},
+		{"two directed starts", func() (EulerianKind, error) {
+			return ClassifyEulerianDirected(map[int][]int{1: {3}, 2: {3}, 3: {4}})
+		}, "vertices 1 and 2 both have out-degree - in-degree = 1"},
+		{"disconnected", func() (EulerianKind, error) {
+			return ClassifyEulerianDirected(map[int][]int{1: {2}, 2: {1}, 3: {4}, 4: {3}})
+		}, "different components"},
+		{"four odd vertices", func() (EulerianKind, error) {
+			return ClassifyEulerianUndirected(map[int][]int{1: {2, 3, 4}, 2: {1}, 3: {1}, 4: {1}, 5: {}})
+		}, "4 vertices have odd degree"},
+		{"asymmetric input", func() (EulerianKind, error) {
+			return ClassifyEulerianUndirected(map[int][]int{1: {2}})
+		}, "not listed from both endpoints"},
+	}
+
+	for _, test := range tests {
+		kind, err := test.check()
+		if kind != NotEulerian || err == nil {
+			t.Errorf("%s: expected NotEulerian with an error, got %v, %v", test.name, kind, err)
+			continue
+		}
+		if !strings.Contains(err.Error(), test.reason) {
+			t.Errorf("%s: error %q should mention %q", test.name, err, test.reason)
+		}
+	}
+}
+
+func TestEulerianDeBruijn(t *testing.T) {
+	// De Bruijn graph B(2, 3): vertices are 2-bit strings, edges append one bit
+	graph := make(map[int][]int)
+	for v := 0; v < 4; v++ {
+		graph[v] = []int{(v << 1) & 3, ((v << 1) | 1) & 3}
+	}
+
+	circuit, err := EulerianCircuitDirected(graph)
+	if err != nil {
+		t.Fatalf("De Bruijn graph should have an Eulerian circuit: %v", err)
+	}
+	checkEulerianWalk(t, graph, circuit, false)
+
+	// Reading the last bit of each step yields every 3-bit window exactly once
+	var sequence strings.Builder
+	for _, v := range circuit[1:] {
+		sequence.WriteByte(byte('0' + v&1))
+	}
+	cyclic := sequence.String() + sequence.String()[:2]
+	seen := make(map[string]bool)
+	for i := 0; i < 8; i++ {
+		seen[cyclic[i:i+3]] = true
+	}
+	if len(seen) != 8 {
+		t.Errorf("De Bruijn sequence %s should contain all 8 windows, got %d", sequence.String(), len(seen))
+	}
+}
+
+func TestEulerianEmptyGraph(t *testing.T) {
+	path, err := EulerianPathDirected(map[int][]int{1: {}})
+	if err != nil || len(path) != 0 {
+		t.Errorf("Graph without edges should have an empty walk, got %v, %v", path, err)
+	}
+}
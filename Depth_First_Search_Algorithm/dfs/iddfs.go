@@ -0,0 +1,156 @@
+package dfs
+
+import "math"
+
+// === Depth-Limited Search, IDDFS and IDA* ===
+
+// The searches in this file work on implicit state spaces: states are generated on
+// demand by a neighbors function, so the graph never has to be materialized. Only the
+// current path is kept in memory, and states already on it are not revisited.
+
+// GraphNeighbors adapts an adjacency map to a neighbor generator
+func GraphNeighbors(graph map[int][]int) func(int) []int {
+	return func(node int) []int {
+		return graph[node]
+	}
+}
+
+// DepthLimitedSearch looks for a goal state at most limit moves from start.
+// It returns the path from start to the goal, or nil and false if none exists
+// within the limit.
+func DepthLimitedSearch[S comparable](start S, isGoal func(S) bool, neighbors func(S) []S, limit int) ([]S, bool) {
+	path, found, _ := depthLimited(start, isGoal, neighbors, limit)
+	return path, found
+}
+
+// IterativeDeepening runs DepthLimitedSearch with limits 0, 1, ..., maxDepth and
+// returns the first path found, which uses the fewest moves. It stops early once a
+// search finishes without hitting the limit, meaning the reachable space is exhausted.
+// A negative maxDepth means no limit.
+func IterativeDeepening[S comparable](start S, isGoal func(S) bool, neighbors func(S) []S, maxDepth int) ([]S, bool) {
+	for limit := 0; maxDepth < 0 || limit <= maxDepth; limit++ {
+		path, found, cutoff := depthLimited(start, isGoal, neighbors, limit)
+		if found {
+			return path, true
+		}
+		if !cutoff {
+			break
+		}
+	}
+	return nil, false
+}
+
+// depthLimited is an explicit-stack depth-limited DFS. cutoff reports whether some
+// branch was cut by the limit, i.e. whether a deeper search could find more.
+func depthLimited[S comparable](start S, isGoal func(S) bool, neighbors func(S) []S, limit int) (path []S, found, cutoff bool) {
+	type frame struct {
+		state S
+		next  []S
+	}
+
+	if isGoal(start) {
+		return []S{start}, true, false
+	}
+
+	onPath := map[S]bool{start: true}
+	path = []S{start}
+	callStack := []frame{{state: start}}
+	if limit > 0 {
+		callStack[0].next = neighbors(start)
+	} else {
+		cutoff = len(neighbors(start)) > 0
+	}
+
+	for len(callStack) > 0 {
+		top := &callStack[len(callStack)-1]
+		if len(top.next) == 0 {
+			onPath[top.state] = false
+			callStack = callStack[:len(callStack)-1]
+			path = path[:len(path)-1]
+			continue
+		}
+
+		state := top.next[0]
+		top.next = top.next[1:]
+		if onPath[state] {
+			continue
+		}
+
+		path = append(path, state)
+		if isGoal(state) {
+			return path, true, cutoff
+		}
+
+		child := frame{state: state}
+		if len(path)-1 < limit {
+			child.next = neighbors(state)
+		} else if len(neighbors(state)) > 0 {
+			cutoff = true
+		}
+		onPath[state] = true
+		callStack = append(callStack, child)
+	}
+	return nil, false, cutoff
+}
+
+// IDAStar finds a cheapest path from start to a goal with iterative-deepening A*.
+// cost gives the (non-negative) cost of moving between neighboring states and
+// heuristic must never overestimate the remaining cost. Each iteration is a DFS bounded
+// by f = g + h, so memory stays proportional to the path length. A positive maxCost
+// stops the search once the bound exceeds it. The path and its cost are returned.
+func IDAStar[S comparable](start S, isGoal func(S) bool, neighbors func(S) []S, cost func(from, to S) int, heuristic func(S) int, maxCost int) ([]S, int, bool) {
+	type frame struct {
+		state S
+		g     int
+		next  []S
+	}
+
+	if isGoal(start) {
+		return []S{start}, 0, true
+	}
+
+	bound := heuristic(start)
+	for maxCost <= 0 || bound <= maxCost {
+		nextBound := math.MaxInt
+		onPath := map[S]bool{start: true}
+		path := []S{start}
+		callStack := []frame{{state: start, next: neighbors(start)}}
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			if len(top.next) == 0 {
+				onPath[top.state] = false
+				callStack = callStack[:len(callStack)-1]
+				path = path[:len(path)-1]
+				continue
+			}
+
+			state := top.next[0]
+			top.next = top.next[1:]
+			if onPath[state] {
+				continue
+			}
+
+			g := top.g + cost(top.state, state)
+			if f := g + heuristic(state); f > bound {
+				if f < nextBound {
+					nextBound = f
+				}
+				continue
+			}
+
+			path = append(path, state)
+			if isGoal(state) {
+				return path, g, true
+			}
+			onPath[state] = true
+			callStack = append(callStack, frame{state: state, g: g, next: neighbors(state)})
+		}
+
+		if nextBound == math.MaxInt {
+			break // every reachable state was explored
+		}
+		bound = nextBound
+	}
+	return nil, 0, false
+}
@@ -0,0 +1,117 @@
+package dfs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDepthLimitedSearch(t *testing.T) {
+	neighbors := GraphNeighbors(createTestGraph())
+	isSix := func(node int) bool { return node == 6 }
+
+	if path, found := DepthLimitedSearch(1, isSix, neighbors, 1); found {
+		t.Errorf("Node 6 is two moves away and should not be found with limit 1, got %v", path)
+	}
+
+	path, found := DepthLimitedSearch(1, isSix, neighbors, 2)
+	if !found || !reflect.DeepEqual(path, []int{1, 3, 6}) {
+		t.Errorf("DepthLimitedSearch(limit 2) = %v, %t; want [1 3 6], true", path, found)
+	}
+}
+
+func TestIterativeDeepening(t *testing.T) {
+	// Implicit, infinite state space: from x move to x+1 or 2x
+	neighbors := func(x int) []int { return []int{x + 1, 2 * x} }
+	isGoal := func(x int) bool { return x == 10 }
+
+	path, found := IterativeDeepening(1, isGoal, neighbors, -1)
+	if !found || len(path) != 5 {
+		t.Fatalf("IterativeDeepening should find a 4-move path to 10, got %v, %t", path, found)
+	}
+	for i := 1; i < len(path); i++ {
+		if path[i] != path[i-1]+1 && path[i] != 2*path[i-1] {
+			t.Errorf("Invalid move %d -> %d in %v", path[i-1], path[i], path)
+		}
+	}
+
+	if _, found := IterativeDeepening(1, isGoal, neighbors, 3); found {
+		t.Error("IterativeDeepening should respect maxDepth")
+	}
+
+	// A finite space without the goal terminates even with no depth limit
+	cyclic := GraphNeighbors(map[int][]int{1: {2}, 2: {3}, 3: {1}})
+	if _, found := IterativeDeepening(1, func(x int) bool { return x == 4 }, cyclic, -1); found {
+		t.Error("IterativeDeepening should report an unreachable goal")
+	}
+}
+
+// puzzle is a 3x3 sliding puzzle state with 0 as the blank
+type puzzle [9]int
+
+func puzzleMoves(p puzzle) []puzzle {
+	blank := 0
+	for i, v := range p {
+		if v == 0 {
+			blank = i
+		}
+	}
+	moves := []puzzle{}
+	row, col := blank/3, blank%3
+	for _, d := range [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+		r, c := row+d[0], col+d[1]
+		if r >= 0 && r < 3 && c >= 0 && c < 3 {
+			next := p
+			next[blank], next[r*3+c] = next[r*3+c], next[blank]
+			moves = append(moves, next)
+		}
+	}
+	return moves
+}
+
+func manhattan(p puzzle) int {
+	total := 0
+	for i, v := range p {
+		if v != 0 {
+			goal := v - 1
+			total += abs(i/3-goal/3) + abs(i%3-goal%3)
+		}
+	}
+	return total
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func TestIDAStar(t *testing.T) {
+	goal := puzzle{1, 2, 3, 4, 5, 6, 7, 8, 0}
+	start := puzzle{4, 1, 3, 7, 2, 6, 0, 5, 8}
+	isGoal := func(p puzzle) bool { return p == goal }
+	unitCost := func(from, to puzzle) int { return 1 }
+
+	path, cost, found := IDAStar(start, isGoal, puzzleMoves, unitCost, manhattan, 0)
+	if !found || cost != 6 || len(path) != 7 {
+		t.Fatalf("IDAStar should solve the puzzle in 6 moves, got cost %d, found %t", cost, found)
+	}
+	if path[0] != start || path[len(path)-1] != goal {
+		t.Error("IDAStar path should run from start to goal")
+	}
+
+	if _, _, found := IDAStar(start, isGoal, puzzleMoves, unitCost, manhattan, 5); found {
+		t.Error("IDAStar should respect maxCost")
+	}
+
+	// Weighted graph where the direct edge is more expensive than the detour
+	graph := map[int][]int{1: {2, 3}, 2: {4}, 3: {4}}
+	weights := map[[2]int]int{{1, 2}: 1, {2, 4}: 5, {1, 3}: 2, {3, 4}: 1}
+	weight := func(from, to int) int { return weights[[2]int{from, to}] }
+	zero := func(int) int { return 0 }
+
+	path2, cost2, found2 := IDAStar(1, func(x int) bool { return x == 4 }, GraphNeighbors(graph), weight, zero, 0)
+	if !found2 || cost2 != 3 || !reflect.DeepEqual(path2, []int{1, 3, 4}) {
+		t.Errorf("IDAStar on weighted graph = %v (cost %d); want [1 3 4] (cost 3)", path2, cost2)
+	}
+}
@@ -0,0 +1,256 @@
+package dfs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// === Explicit-Stack Versions of the Recursive Traversals ===
+//
+// Each function below produces exactly the same output, in the same order, as
+// its recursive counterpart in dfs.go, but keeps its own stack on the heap so that
+// deep graphs (long chains, degenerate trees) do not grow the goroutine stack.
+
+// DFSVisitIterative is the explicit-stack version of DFSVisit
+func DFSVisitIterative(graph map[int][]int, start int, visited map[int]bool, visit func(node int) bool) bool {
+	type frame struct {
+		node, next int
+	}
+
+	visited[start] = true
+	if !visit(start) {
+		return false
+	}
+	callStack := []frame{{node: start}}
+
+	for len(callStack) > 0 {
+		top := &callStack[len(callStack)-1]
+		if top.next < len(graph[top.node]) {
+			neighbor := graph[top.node][top.next]
+			top.next++
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				if !visit(neighbor) {
+					return false
+				}
+				callStack = append(callStack, frame{node: neighbor})
+			}
+			continue
+		}
+		callStack = callStack[:len(callStack)-1]
+	}
+	return true
+}
+
+// DFSPathIterative is the explicit-stack version of DFSPath. The path itself
+// doubles as the stack of vertices being explored.
+func DFSPathIterative(graph map[int][]int, current, target int, visited map[int]bool, path *[]int) bool {
+	base := len(*path)
+	visited[current] = true
+	*path = append(*path, current)
+	if current == target {
+		return true
+	}
+
+	next := []int{0} // Index of the next neighbour to try for each vertex on the path
+	for len(next) > 0 {
+		depth := len(next) - 1
+		neighbors := graph[(*path)[base+depth]]
+		if next[depth] < len(neighbors) {
+			neighbor := neighbors[next[depth]]
+			next[depth]++
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				*path = append(*path, neighbor)
+				if neighbor == target {
+					return true
+				}
+				next = append(next, 0)
+			}
+			continue
+		}
+		// Backtrack
+		next = next[:depth]
+		*path = (*path)[:base+depth]
+	}
+	return false
+}
+
+// DFSCycleUndirectedIterative is the explicit-stack version of DFSCycleUndirected
+func DFSCycleUndirectedIterative(graph map[int][]int, current, parent int, visited map[int]bool) bool {
+	type frame struct {
+		node, parent, next int
+	}
+
+	visited[current] = true
+	callStack := []frame{{node: current, parent: parent}}
+
+	for len(callStack) > 0 {
+		top := &callStack[len(callStack)-1]
+		if top.next < len(graph[top.node]) {
+			neighbor := graph[top.node][top.next]
+			top.next++
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				callStack = append(callStack, frame{node: neighbor, parent: top.node})
+			} else if neighbor != top.parent {
+				return true // Back edge found
+			}
+			continue
+		}
+		callStack = callStack[:len(callStack)-1]
+	}
+	return false
+}
+
+// DFSCycleDirectedIterative is the explicit-stack version of DFSCycleDirected
+func DFSCycleDirectedIterative(graph map[int][]int, node int, visited, recStack map[int]bool) bool {
+	type frame struct {
+		node, next int
+	}
+
+	visited[node] = true
+	recStack[node] = true
+	callStack := []frame{{node: node}}
+
+	for len(callStack) > 0 {
+		top := &callStack[len(callStack)-1]
+		if top.next < len(graph[top.node]) {
+			neighbor := graph[top.node][top.next]
+			top.next++
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				recStack[neighbor] = true
+				callStack = append(callStack, frame{node: neighbor})
+			} else if recStack[neighbor] {
+				return true // Back edge in recursion stack
+			}
+			continue
+		}
+		recStack[top.node] = false
+		callStack = callStack[:len(callStack)-1]
+	}
+	return false
+}
+
+// DFSComponentIterative is the explicit-stack version of DFSComponent
+func DFSComponentIterative(graph map[int][]int, start int, visited map[int]bool) {
+	DFSVisitIterative(graph, start, visited, func(int) bool { return true })
+}
+
+// DFSGetComponentIterative is the explicit-stack version of DFSGetComponent
+func DFSGetComponentIterative(graph map[int][]int, node int, visited map[int]bool, component *[]int) {
+	DFSVisitIterative(graph, node, visited, func(v int) bool {
+		*component = append(*component, v)
+		return true
+	})
+}
+
+// DFSTopologicalIterative is the explicit-stack version of DFSTopological
+func DFSTopologicalIterative(graph map[int][]int, node int, visited map[int]bool, stack *[]int) {
+	*stack = append(*stack, postOrderFrom(graph, node, visited)...)
+}
+
+// DFSDebugHelperIterative is the explicit-stack version of DFSDebugHelper
+func DFSDebugHelperIterative(w io.Writer, graph map[int][]int, node int, visited map[int]bool, maxDepth, currentDepth int) {
+	type frame struct {
+		node, depth, next int
+	}
+
+	// enter traces v and reports whether its neighbours should be explored
+	enter := func(v, depth int) bool {
+		indent := strings.Repeat("  ", depth)
+		fmt.Fprintf(w, "%sVisiting node %d at depth %d\n", indent, v, depth)
+		visited[v] = true
+		if depth >= maxDepth {
+			fmt.Fprintf(w, "%sMax depth reached\n", indent)
+			return false
+		}
+		return true
+	}
+
+	if !enter(node, currentDepth) {
+		return
+	}
+	callStack := []frame{{node: node, depth: currentDepth}}
+
+	for len(callStack) > 0 {
+		top := &callStack[len(callStack)-1]
+		if top.next < len(graph[top.node]) {
+			neighbor := graph[top.node][top.next]
+			top.next++
+			if !visited[neighbor] && enter(neighbor, top.depth+1) {
+				callStack = append(callStack, frame{node: neighbor, depth: top.depth + 1})
+			}
+			continue
+		}
+		callStack = callStack[:len(callStack)-1]
+	}
+}
+
+// InOrderIterative is the explicit-stack version of InOrder
+func InOrderIterative(root *TreeNode) []int {
+	result := []int{}
+	stack := []*TreeNode{}
+
+	for node := root; node != nil || len(stack) > 0; {
+		// Walk as far left as possible, then visit and turn right
+		for ; node != nil; node = node.Left {
+			stack = append(stack, node)
+		}
+		node = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		result = append(result, node.Val)
+		node = node.Right
+	}
+	return result
+}
+
+// PostOrderIterative is the explicit-stack version of PostOrder
+func PostOrderIterative(root *TreeNode) []int {
+	result := []int{}
+	stack := []*TreeNode{}
+	var last *TreeNode // Most recently emitted node
+
+	for node := root; node != nil || len(stack) > 0; {
+		for ; node != nil; node = node.Left {
+			stack = append(stack, node)
+		}
+		top := stack[len(stack)-1]
+		if top.Right != nil && top.Right != last {
+			// Right subtree not done yet
+			node = top.Right
+			continue
+		}
+		stack = stack[:len(stack)-1]
+		result = append(result, top.Val)
+		last = top
+	}
+	return result
+}
+
+// MaxDepthIterative is the explicit-stack version of MaxDepth
+func MaxDepthIterative(root *TreeNode) int {
+	type frame struct {
+		node  *TreeNode
+		depth int
+	}
+
+	deepest := 0
+	stack := []frame{}
+	if root != nil {
+		stack = append(stack, frame{node: root, depth: 1})
+	}
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		deepest = max(deepest, top.depth)
+		for _, child := range []*TreeNode{top.node.Left, top.node.Right} {
+			if child != nil {
+				stack = append(stack, frame{node: child, depth: top.depth + 1})
+			}
+		}
+	}
+	return deepest
+}
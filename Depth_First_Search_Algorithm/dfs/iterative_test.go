@@ -0,0 +1,208 @@
+package dfs
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"runtime/debug"
+	"testing"
+)
+
+// Test helper: path graph 0 -> 1 -> ... -> n-1
+func createChainGraph(n int) map[int][]int {
+	graph := make(map[int][]int, n)
+	edges := make([]int, n)
+	for i := 0; i < n-1; i++ {
+		edges[i] = i + 1
+		graph[i] = edges[i : i+1 : i+1]
+	}
+	graph[n-1] = []int{}
+	return graph
+}
+
+// Test helper: random directed graph on vertices 0..n-1
+func createRandomGraph(rng *rand.Rand, n int) map[int][]int {
+	graph := make(map[int][]int, n)
+	for u := 0; u < n; u++ {
+		graph[u] = []int{}
+		for v := 0; v < n; v++ {
+			if rng.Intn(4) == 0 {
+				graph[u] = append(graph[u], v)
+			}
+		}
+	}
+	return graph
+}
+
+// Test helper: random binary tree with n nodes
+func createRandomTree(rng *rand.Rand, n int) *TreeNode {
+	if n == 0 {
+		return nil
+	}
+	left := rng.Intn(n)
+	return &TreeNode{
+		Val:   n,
+		Left:  createRandomTree(rng, left),
+		Right: createRandomTree(rng, n-1-left),
+	}
+}
+
+func TestIterativeMatchesRecursiveOnGraphs(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	for trial := 0; trial < 200; trial++ {
+		graph := createRandomGraph(rng, 1+rng.Intn(10))
+		start, target := rng.Intn(len(graph)), rng.Intn(len(graph))
+
+		recursive := DFSRecursive(graph, start, make(map[int]bool))
+		if got := DFSIterative(graph, start); fmt.Sprint(got) != fmt.Sprint(recursive) {
+			t.Fatalf("DFSIterative = %v; want %v in %v", got, recursive, graph)
+		}
+		iterative := []int{}
+		DFSVisitIterative(graph, start, make(map[int]bool), func(node int) bool {
+			iterative = append(iterative, node)
+			return true
+		})
+		if fmt.Sprint(iterative) != fmt.Sprint(recursive) {
+			t.Fatalf("DFSVisitIterative = %v; want %v in %v", iterative, recursive, graph)
+		}
+
+		wantPath, gotPath := []int{-1}, []int{-1}
+		wantVisited, gotVisited := make(map[int]bool), make(map[int]bool)
+		wantFound := DFSPath(graph, start, target, wantVisited, &wantPath)
+		gotFound := DFSPathIterative(graph, start, target, gotVisited, &gotPath)
+		if gotFound != wantFound || fmt.Sprint(gotPath) != fmt.Sprint(wantPath) || len(gotVisited) != len(wantVisited) {
+			t.Fatalf("DFSPathIterative(%d, %d) = %v %v; want %v %v in %v",
+				start, target, gotFound, gotPath, wantFound, wantPath, graph)
+		}
+
+		wantCycle := DFSCycleDirected(graph, start, make(map[int]bool), make(map[int]bool))
+		if got := DFSCycleDirectedIterative(graph, start, make(map[int]bool), make(map[int]bool)); got != wantCycle {
+			t.Fatalf("DFSCycleDirectedIterative = %v; want %v in %v", got, wantCycle, graph)
+		}
+
+		undirected := makeUndirected(graph)
+		wantCycle = DFSCycleUndirected(undirected, start, -1, make(map[int]bool))
+		if got := DFSCycleUndirectedIterative(undirected, start, -1, make(map[int]bool)); got != wantCycle {
+			t.Fatalf("DFSCycleUndirectedIterative = %v; want %v in %v", got, wantCycle, undirected)
+		}
+
+		wantComponent, gotComponent := []int{}, []int{}
+		DFSGetComponent(undirected, start, make(map[int]bool), &wantComponent)
+		DFSGetComponentIterative(undirected, start, make(map[int]bool), &gotComponent)
+		if fmt.Sprint(gotComponent) != fmt.Sprint(wantComponent) {
+			t.Fatalf("DFSGetComponentIterative = %v; want %v", gotComponent, wantComponent)
+		}
+
+		wantVisited, gotVisited = make(map[int]bool), make(map[int]bool)
+		DFSComponent(graph, start, wantVisited)
+		DFSComponentIterative(graph, start, gotVisited)
+		if len(gotVisited) != len(wantVisited) {
+			t.Fatalf("DFSComponentIterative visited %d vertices; want %d", len(gotVisited), len(wantVisited))
+		}
+
+		wantPaths := [][]int{}
+		DFSAllPaths(graph, start, target, make(map[int]bool), nil, &wantPaths)
+		if got := FindAllPaths(graph, start, target); fmt.Sprint(got) != fmt.Sprint(wantPaths) {
+			t.Fatalf("FindAllPaths(%d, %d) = %v; want %v in %v", start, target, got, wantPaths, graph)
+		}
+
+		var wantTrace, gotTrace bytes.Buffer
+		maxDepth := rng.Intn(5)
+		DFSDebugHelper(&wantTrace, graph, start, make(map[int]bool), maxDepth, 0)
+		DFSDebug(&gotTrace, graph, start, maxDepth)
+		if gotTrace.String() != wantTrace.String() {
+			t.Fatalf("DFSDebug trace =\n%s\nwant\n%s", gotTrace.String(), wantTrace.String())
+		}
+
+		wantOrder, gotOrder := []int{}, []int{}
+		DFSTopological(graph, start, make(map[int]bool), &wantOrder)
+		DFSTopologicalIterative(graph, start, make(map[int]bool), &gotOrder)
+		if fmt.Sprint(gotOrder) != fmt.Sprint(wantOrder) {
+			t.Fatalf("DFSTopologicalIterative = %v; want %v in %v", gotOrder, wantOrder, graph)
+		}
+	}
+}
+
+func TestIterativeMatchesRecursiveOnTrees(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	for trial := 0; trial < 100; trial++ {
+		root := createRandomTree(rng, rng.Intn(30))
+		checks := []struct {
+			name           string
+			recursive, got []int
+		}{
+			{"PreOrder", PreOrder(root), PreOrderIterative(root)},
+			{"InOrder", InOrder(root), InOrderIterative(root)},
+			{"PostOrder", PostOrder(root), PostOrderIterative(root)},
+		}
+		for _, check := range checks {
+			if fmt.Sprint(check.got) != fmt.Sprint(check.recursive) {
+				t.Fatalf("%sIterative = %v; want %v", check.name, check.got, check.recursive)
+			}
+		}
+		if got, want := MaxDepthIterative(root), MaxDepth(root); got != want {
+			t.Fatalf("MaxDepthIterative = %d; want %d", got, want)
+		}
+	}
+}
+
+func TestIterativeOnMillionNodeChains(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping million-node traversals in short mode")
+	}
+
+	// Cap goroutine stacks far below what a million nested calls would need:
+	// any recursion proportional to the depth aborts the test binary
+	defer debug.SetMaxStack(debug.SetMaxStack(1 << 20))
+
+	const n = 1000000
+	graph := createChainGraph(n)
+
+	if order := DFSIterative(graph, 0); len(order) != n || order[n-1] != n-1 {
+		t.Errorf("DFSIterative visited %d vertices", len(order))
+	}
+	count := 0
+	DFSVisitIterative(graph, 0, make(map[int]bool), func(int) bool {
+		count++
+		return true
+	})
+	if count != n {
+		t.Errorf("DFSVisitIterative visited %d vertices; want %d", count, n)
+	}
+	if path := FindPath(graph, 0, n-1); len(path) != n {
+		t.Errorf("FindPath length = %d; want %d", len(path), n)
+	}
+	if HasCycleDirected(graph) || HasCycleUndirected(graph) {
+		t.Error("A chain has no cycles")
+	}
+	if got := CountComponents(graph); got != 1 {
+		t.Errorf("CountComponents = %d; want 1", got)
+	}
+	if order := TopologicalSort(graph); len(order) != n || order[0] != 0 || order[n-1] != n-1 {
+		t.Error("TopologicalSort of a chain should list it front to back")
+	}
+
+	graph[n-1] = []int{0}
+	if !HasCycleDirected(graph) {
+		t.Error("Closing the chain should create a directed cycle")
+	}
+
+	// Degenerate trees leaning left and right
+	var left, right *TreeNode
+	for i := n; i >= 1; i-- {
+		left = &TreeNode{Val: i, Left: left}
+		right = &TreeNode{Val: i, Right: right}
+	}
+	if got := InOrderIterative(left); len(got) != n || got[0] != n {
+		t.Error("InOrderIterative on a left chain should start at the deepest node")
+	}
+	if got := PostOrderIterative(right); len(got) != n || got[0] != n || got[n-1] != 1 {
+		t.Error("PostOrderIterative on a right chain should end at the root")
+	}
+	if got := PreOrderIterative(left); len(got) != n || got[0] != 1 {
+		t.Error("PreOrderIterative on a left chain should start at the root")
+	}
+	if got := MaxDepthIterative(right); got != n {
+		t.Errorf("MaxDepthIterative = %d; want %d", got, n)
+	}
+}
@@ -0,0 +1,149 @@
+package dfs
+
+// === Elementary Cycles (Johnson's Algorithm) ===
+
+// CycleLimits bounds the cycle enumeration. Zero values mean no limit.
+type CycleLimits struct {
+	MaxLength int // Maximum number of edges in a reported cycle
+	MaxCount  int // Maximum number of cycles to report
+}
+
+// ElementaryCycles streams every elementary cycle of a directed graph to visit using
+// Johnson's algorithm. Each cycle starts at its smallest vertex and repeats it at the
+// end, e.g. [1 2 3 1]. The slice passed to visit is reused, so copy it to keep it.
+// Returning false from visit stops the enumeration. The number of cycles reported
+// is returned. Parallel edges are treated as a single edge.
+func ElementaryCycles(graph map[int][]int, limits CycleLimits, visit func(cycle []int) bool) int {
+	vertices := sortedVertices(graph)
+	count := 0
+
+	type frame struct {
+		node, next int
+		found      bool
+	}
+
+	for i, start := range vertices {
+		// Restrict to the strongly connected component of start among vertices >= start
+		component := sccContaining(graph, vertices[i:], start)
+		adj := make(map[int][]int, len(component))
+		for node := range component {
+			for _, neighbor := range graph[node] {
+				if component[neighbor] && !contains(adj[node], neighbor) {
+					adj[node] = append(adj[node], neighbor)
+				}
+			}
+		}
+		if len(adj[start]) == 0 {
+			continue
+		}
+
+		blocked := make(map[int]bool)
+		blockedBy := make(map[int][]int)
+		path := []int{start}
+		blocked[start] = true
+		callStack := []frame{{node: start}}
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			node := top.node
+
+			if top.next < len(adj[node]) {
+				neighbor := adj[node][top.next]
+				top.next++
+
+				switch {
+				case neighbor == start:
+					top.found = true
+					count++
+					if !visit(append(path, start)) || count == limits.MaxCount {
+						return count
+					}
+				case blocked[neighbor]:
+				case limits.MaxLength > 0 && len(path) >= limits.MaxLength:
+					// The length limit, not the blocking rule, cut this branch,
+					// so the vertex must not stay blocked
+					top.found = true
+				default:
+					blocked[neighbor] = true
+					path = append(path, neighbor)
+					callStack = append(callStack, frame{node: neighbor})
+				}
+				continue
+			}
+
+			// All edges explored: unblock on success, otherwise wait on the neighbors
+			if top.found {
+				unblock(node, blocked, blockedBy)
+			} else {
+				for _, neighbor := range adj[node] {
+					if !contains(blockedBy[neighbor], node) {
+						blockedBy[neighbor] = append(blockedBy[neighbor], node)
+					}
+				}
+			}
+			found := top.found
+			callStack = callStack[:len(callStack)-1]
+			path = path[:len(path)-1]
+			if len(callStack) > 0 && found {
+				callStack[len(callStack)-1].found = true
+			}
+		}
+	}
+	return count
+}
+
+// AllCycles collects the elementary cycles reported by ElementaryCycles
+func AllCycles(graph map[int][]int, limits CycleLimits) [][]int {
+	cycles := [][]int{}
+	ElementaryCycles(graph, limits, func(cycle []int) bool {
+		cycles = append(cycles, append([]int(nil), cycle...))
+		return true
+	})
+	return cycles
+}
+
+// unblock clears the blocked flag of node and, transitively, of vertices waiting on it
+func unblock(node int, blocked map[int]bool, blockedBy map[int][]int) {
+	stack := []int{node}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !blocked[current] {
+			continue
+		}
+		blocked[current] = false
+		for _, waiting := range blockedBy[current] {
+			stack = append(stack, waiting)
+		}
+		delete(blockedBy, current)
+	}
+}
+
+// sccContaining returns the strongly connected component of start in the subgraph
+// induced by the given vertices
+func sccContaining(graph map[int][]int, vertices []int, start int) map[int]bool {
+	allowed := make(map[int]bool, len(vertices))
+	for _, node := range vertices {
+		allowed[node] = true
+	}
+	subgraph := make(map[int][]int, len(vertices))
+	for _, node := range vertices {
+		subgraph[node] = []int{}
+		for _, neighbor := range graph[node] {
+			if allowed[neighbor] {
+				subgraph[node] = append(subgraph[node], neighbor)
+			}
+		}
+	}
+
+	for _, component := range TarjanSCC(subgraph) {
+		if contains(component, start) {
+			members := make(map[int]bool, len(component))
+			for _, node := range component {
+				members[node] = true
+			}
+			return members
+		}
+	}
+	return map[int]bool{start: true}
+}
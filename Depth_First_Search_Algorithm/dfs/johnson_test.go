@@ -0,0 +1,118 @@
+package dfs
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestElementaryCycles(t *testing.T) {
+	graph := map[int][]int{
+		1: {2},
+		2: {3, 1},
+		3: {1, 3},
+	}
+
+	result := AllCycles(graph, CycleLimits{})
+	expected := [][]int{{1, 2, 3, 1}, {1, 2, 1}, {3, 3}}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("AllCycles failed. Expected %v, got %v", expected, result)
+	}
+
+	// A DAG has no cycles
+	if cycles := AllCycles(createTestGraph(), CycleLimits{}); len(cycles) != 0 {
+		t.Errorf("DAG should have no cycles, got %v", cycles)
+	}
+}
+
+func TestElementaryCyclesCompleteGraph(t *testing.T) {
+	// The complete digraph on 5 vertices has sum C(5,k)(k-1)! = 84 cycles
+	graph := make(map[int][]int)
+	for u := 0; u < 5; u++ {
+		for v := 0; v < 5; v++ {
+			if u != v {
+				graph[u] = append(graph[u], v)
+			}
+		}
+	}
+
+	if n := len(AllCycles(graph, CycleLimits{})); n != 84 {
+		t.Errorf("K5 should have 84 elementary cycles, got %d", n)
+	}
+
+	// Only the C(5,2) = 10 two-cycles have length 2
+	if n := len(AllCycles(graph, CycleLimits{MaxLength: 2})); n != 10 {
+		t.Errorf("K5 should have 10 cycles of length 2, got %d", n)
+	}
+
+	// Length 3 adds C(5,3) * 2 = 20 triangles
+	if n := len(AllCycles(graph, CycleLimits{MaxLength: 3})); n != 30 {
+		t.Errorf("K5 should have 30 cycles of length <= 3, got %d", n)
+	}
+
+	if n := len(AllCycles(graph, CycleLimits{MaxCount: 7})); n != 7 {
+		t.Errorf("MaxCount should stop after 7 cycles, got %d", n)
+	}
+}
+
+func TestElementaryCyclesEarlyStop(t *testing.T) {
+	graph := map[int][]int{1: {2}, 2: {1, 3}, 3: {2}}
+
+	visits := 0
+	count := ElementaryCycles(graph, CycleLimits{}, func(cycle []int) bool {
+		visits++
+		return false
+	})
+	if visits != 1 || count != 1 {
+		t.Errorf("Returning false should stop after one cycle, got %d visits and count %d", visits, count)
+	}
+}
+
+func TestElementaryCyclesMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+
+	for trial := 0; trial < 30; trial++ {
+		graph := make(map[int][]int)
+		for u := 0; u < 7; u++ {
+			graph[u] = []int{}
+			for v := 0; v < 7; v++ {
+				if rng.Intn(3) == 0 {
+					graph[u] = append(graph[u], v)
+				}
+			}
+		}
+
+		for _, maxLength := range []int{0, 3} {
+			got := len(AllCycles(graph, CycleLimits{MaxLength: maxLength}))
+			want := bruteForceCycles(graph, maxLength)
+			if got != want {
+				t.Fatalf("Graph %v (max length %d): expected %d cycles, got %d", graph, maxLength, want, got)
+			}
+		}
+	}
+}
+
+// bruteForceCycles counts cycles whose smallest vertex is their start
+func bruteForceCycles(graph map[int][]int, maxLength int) int {
+	count := 0
+	var extend func(start, node int, onPath map[int]bool, length int)
+	extend = func(start, node int, onPath map[int]bool, length int) {
+		for _, next := range graph[node] {
+			if maxLength > 0 && length+1 > maxLength {
+				continue
+			}
+			if next == start {
+				count++
+			} else if next > start && !onPath[next] {
+				onPath[next] = true
+				extend(start, next, onPath, length+1)
+				onPath[next] = false
+			}
+		}
+	}
+	for start := range graph {
+		extend(start, start, map[int]bool{start: true}, 0)
+	}
+	return count
+}
@@ -0,0 +1,257 @@
+package dfs
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// === Lowest Common Ancestor ===
+
+// LCA answers ancestor queries on a rooted forest with vertices 0..n-1.
+// Preprocessing takes O(n log n); Query uses binary lifting in O(log n) and
+// QueryRMQ uses an Euler tour with a sparse table in O(1).
+type LCA struct {
+	parent []int
+	depth  []int
+	root   []int   // Root of each vertex's tree
+	up     [][]int // up[j][v] is the 2^j-th ancestor of v, or -1
+
+	first  []int   // Index of each vertex's first occurrence in euler
+	euler  []int   // Euler tour of every tree, concatenated
+	sparse [][]int // sparse[j][i] is the shallowest vertex in euler[i : i+2^j]
+}
+
+// NewLCA preprocesses a forest given as a parent array, where roots have parent -1
+func NewLCA(parent []int) (*LCA, error) {
+	n := len(parent)
+	children := make([][]int, n)
+	roots := []int{}
+	for v, p := range parent {
+		switch {
+		case p == -1:
+			roots = append(roots, v)
+		case p < 0 || p >= n:
+			return nil, fmt.Errorf("vertex %d has invalid parent %d", v, p)
+		default:
+			children[p] = append(children[p], v)
+		}
+	}
+
+	l := &LCA{
+		parent: append([]int(nil), parent...),
+		depth:  make([]int, n),
+		root:   make([]int, n),
+		first:  make([]int, n),
+		euler:  make([]int, 0, 2*n),
+	}
+
+	// Iterative DFS producing depths and the Euler tour
+	type frame struct {
+		node, next int
+	}
+	reached := 0
+	for _, r := range roots {
+		l.depth[r] = 0
+		l.root[r] = r
+		l.first[r] = len(l.euler)
+		l.euler = append(l.euler, r)
+		reached++
+		stack := []frame{{node: r}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.next < len(children[top.node]) {
+				child := children[top.node][top.next]
+				top.next++
+				l.depth[child] = l.depth[top.node] + 1
+				l.root[child] = r
+				l.first[child] = len(l.euler)
+				l.euler = append(l.euler, child)
+				reached++
+				stack = append(stack, frame{node: child})
+				continue
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				l.euler = append(l.euler, stack[len(stack)-1].node)
+			}
+		}
+	}
+	if reached != n {
+		return nil, fmt.Errorf("parent array contains a cycle: %d of %d vertices reachable from a root", reached, n)
+	}
+
+	// Binary lifting table
+	levels := max(bits.Len(uint(n)), 1)
+	l.up = make([][]int, levels)
+	l.up[0] = l.parent
+	for j := 1; j < levels; j++ {
+		l.up[j] = make([]int, n)
+		for v := 0; v < n; v++ {
+			if mid := l.up[j-1][v]; mid != -1 {
+				l.up[j][v] = l.up[j-1][mid]
+			} else {
+				l.up[j][v] = -1
+			}
+		}
+	}
+
+	// Sparse table over the Euler tour
+	m := len(l.euler)
+	l.sparse = [][]int{append([]int(nil), l.euler...)}
+	for j := 1; 1<<j <= m; j++ {
+		prev := l.sparse[j-1]
+		row := make([]int, m-(1<<j)+1)
+		for i := range row {
+			row[i] = l.shallower(prev[i], prev[i+1<<(j-1)])
+		}
+		l.sparse = append(l.sparse, row)
+	}
+	return l, nil
+}
+
+// Depth returns the number of edges between v and its root
+func (l *LCA) Depth(v int) int {
+	return l.depth[v]
+}
+
+// KthAncestor returns the ancestor k levels above v (v itself for k = 0),
+// or -1 if v is less than k levels deep
+func (l *LCA) KthAncestor(v, k int) int {
+	if k < 0 || k > l.depth[v] {
+		return -1
+	}
+	for j := 0; k > 0; j++ {
+		if k&1 == 1 {
+			v = l.up[j][v]
+		}
+		k >>= 1
+	}
+	return v
+}
+
+// Query returns the lowest common ancestor of u and v with binary lifting,
+// or -1 if they are in different trees
+func (l *LCA) Query(u, v int) int {
+	if l.root[u] != l.root[v] {
+		return -1
+	}
+	if l.depth[u] < l.depth[v] {
+		u, v = v, u
+	}
+	u = l.KthAncestor(u, l.depth[u]-l.depth[v])
+	if u == v {
+		return u
+	}
+	for j := len(l.up) - 1; j >= 0; j-- {
+		if l.up[j][u] != l.up[j][v] {
+			u, v = l.up[j][u], l.up[j][v]
+		}
+	}
+	return l.parent[u]
+}
+
+// QueryRMQ returns the lowest common ancestor of u and v in constant time using the
+// Euler tour, or -1 if they are in different trees
+func (l *LCA) QueryRMQ(u, v int) int {
+	if l.root[u] != l.root[v] {
+		return -1
+	}
+	i, j := l.first[u], l.first[v]
+	if i > j {
+		i, j = j, i
+	}
+	k := bits.Len(uint(j-i+1)) - 1
+	return l.shallower(l.sparse[k][i], l.sparse[k][j-(1<<k)+1])
+}
+
+// Distance returns the number of edges on the path between u and v,
+// or -1 if they are in different trees
+func (l *LCA) Distance(u, v int) int {
+	a := l.QueryRMQ(u, v)
+	if a == -1 {
+		return -1
+	}
+	return l.depth[u] + l.depth[v] - 2*l.depth[a]
+}
+
+// shallower returns whichever vertex is closer to the root
+func (l *LCA) shallower(u, v int) int {
+	if l.depth[v] < l.depth[u] {
+		return v
+	}
+	return u
+}
+
+// TreeLCA answers ancestor queries on a binary tree of TreeNodes
+type TreeLCA struct {
+	lca   *LCA
+	ids   map[*TreeNode]int
+	nodes []*TreeNode
+}
+
+// NewTreeLCA preprocesses the binary tree rooted at root
+func NewTreeLCA(root *TreeNode) *TreeLCA {
+	t := &TreeLCA{ids: make(map[*TreeNode]int)}
+	parent := []int{}
+	if root != nil {
+		t.ids[root] = 0
+		t.nodes = append(t.nodes, root)
+		parent = append(parent, -1)
+	}
+
+	// Number the nodes in BFS order so no recursion is needed
+	for i := 0; i < len(t.nodes); i++ {
+		for _, child := range []*TreeNode{t.nodes[i].Left, t.nodes[i].Right} {
+			if child != nil {
+				t.ids[child] = len(t.nodes)
+				t.nodes = append(t.nodes, child)
+				parent = append(parent, i)
+			}
+		}
+	}
+
+	t.lca, _ = NewLCA(parent) // a tree built from pointers cannot contain a cycle
+	return t
+}
+
+// Ancestor returns the lowest common ancestor of a and b, or nil if either is not in the tree
+func (t *TreeLCA) Ancestor(a, b *TreeNode) *TreeNode {
+	u, ok1 := t.ids[a]
+	v, ok2 := t.ids[b]
+	if !ok1 || !ok2 {
+		return nil
+	}
+	return t.nodes[t.lca.QueryRMQ(u, v)]
+}
+
+// Depth returns the depth of node (the root has depth 0), or -1 if it is not in the tree
+func (t *TreeLCA) Depth(node *TreeNode) int {
+	id, ok := t.ids[node]
+	if !ok {
+		return -1
+	}
+	return t.lca.Depth(id)
+}
+
+// KthAncestor returns the ancestor k levels above node, or nil if there is none
+func (t *TreeLCA) KthAncestor(node *TreeNode, k int) *TreeNode {
+	id, ok := t.ids[node]
+	if !ok {
+		return nil
+	}
+	if a := t.lca.KthAncestor(id, k); a != -1 {
+		return t.nodes[a]
+	}
+	return nil
+}
+
+// Distance returns the number of edges between a and b, or -1 if either is not in the tree
+func (t *TreeLCA) Distance(a, b *TreeNode) int {
+	u, ok1 := t.ids[a]
+	v, ok2 := t.ids[b]
+	if !ok1 || !ok2 {
+		return -1
+	}
+	return t.lca.Distance(u, v)
+}
@@ -0,0 +1,147 @@
+package dfs
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// Test helper: parent array for
+//
+//	     0
+//	   / | \
+//	  1  2  3
+//	 / \     \
+//	4   5     6
+//	    |
+//	    7
+func createParentTree() []int {
+	return []int{-1, 0, 0, 0, 1, 1, 3, 5}
+}
+
+func TestLCAQueries(t *testing.T) {
+	l, err := NewLCA(createParentTree())
+	if err != nil {
+		t.Fatalf("NewLCA failed: %v", err)
+	}
+
+	tests := []struct {
+		u, v, lca, distance int
+	}{
+		{4, 7, 1, 3},
+		{7, 6, 0, 5},
+		{5, 7, 5, 1},
+		{2, 2, 2, 0},
+		{4, 5, 1, 2},
+	}
+	for _, test := range tests {
+		if got := l.Query(test.u, test.v); got != test.lca {
+			t.Errorf("Query(%d, %d) = %d; want %d", test.u, test.v, got, test.lca)
+		}
+		if got := l.QueryRMQ(test.u, test.v); got != test.lca {
+			t.Errorf("QueryRMQ(%d, %d) = %d; want %d", test.u, test.v, got, test.lca)
+		}
+		if got := l.Distance(test.u, test.v); got != test.distance {
+			t.Errorf("Distance(%d, %d) = %d; want %d", test.u, test.v, got, test.distance)
+		}
+	}
+
+	if l.Depth(7) != 3 || l.KthAncestor(7, 2) != 1 || l.KthAncestor(7, 3) != 0 || l.KthAncestor(7, 4) != -1 {
+		t.Error("Depth or KthAncestor returned wrong results for vertex 7")
+	}
+}
+
+func TestLCAForestAndErrors(t *testing.T) {
+	// Two trees: 0 -> 1 and 2 -> 3
+	l, err := NewLCA([]int{-1, 0, -1, 2})
+	if err != nil {
+		t.Fatalf("NewLCA failed: %v", err)
+	}
+	if l.Query(1, 3) != -1 || l.QueryRMQ(1, 3) != -1 || l.Distance(1, 3) != -1 {
+		t.Error("Vertices in different trees should have no common ancestor")
+	}
+
+	if _, err := NewLCA([]int{1, 0}); err == nil {
+		t.Error("NewLCA should reject a parent cycle")
+	}
+	if _, err := NewLCA([]int{-1, 5}); err == nil {
+		t.Error("NewLCA should reject an out-of-range parent")
+	}
+}
+
+func TestLCAMatchesNaive(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	n := 500
+	parent := make([]int, n)
+	parent[0] = -1
+	for v := 1; v < n; v++ {
+		parent[v] = rng.Intn(v)
+	}
+
+	l, _ := NewLCA(parent)
+	naive := func(u, v int) int {
+		ancestors := map[int]bool{}
+		for x := u; x != -1; x = parent[x] {
+			ancestors[x] = true
+		}
+		for x := v; ; x = parent[x] {
+			if ancestors[x] {
+				return x
+			}
+		}
+	}
+
+	for i := 0; i < 2000; i++ {
+		u, v := rng.Intn(n), rng.Intn(n)
+		want := naive(u, v)
+		if got := l.Query(u, v); got != want {
+			t.Fatalf("Query(%d, %d) = %d; want %d", u, v, got, want)
+		}
+		if got := l.QueryRMQ(u, v); got != want {
+			t.Fatalf("QueryRMQ(%d, %d) = %d; want %d", u, v, got, want)
+		}
+	}
+}
+
+func TestLCADeepChain(t *testing.T) {
+	n := 1000000
+	parent := make([]int, n)
+	for v := range parent {
+		parent[v] = v - 1
+	}
+
+	l, err := NewLCA(parent)
+	if err != nil {
+		t.Fatalf("NewLCA failed: %v", err)
+	}
+	if got := l.Query(n-1, n/2); got != n/2 {
+		t.Errorf("Query on chain = %d; want %d", got, n/2)
+	}
+	if got := l.KthAncestor(n-1, n-1); got != 0 {
+		t.Errorf("KthAncestor to root = %d; want 0", got)
+	}
+}
+
+func TestTreeLCA(t *testing.T) {
+	root := createTestTree()
+	four, five, three := root.Left.Left, root.Left.Right, root.Right
+	tree := NewTreeLCA(root)
+
+	if got := tree.Ancestor(four, five); got != root.Left {
+		t.Errorf("Ancestor(4, 5) = %v; want node 2", got)
+	}
+	if got := tree.Ancestor(four, three); got != root {
+		t.Errorf("Ancestor(4, 3) = %v; want root", got)
+	}
+	if tree.Depth(five) != 2 || tree.Distance(four, three) != 3 {
+		t.Error("Depth or Distance returned wrong results")
+	}
+	if tree.KthAncestor(four, 2) != root || tree.KthAncestor(four, 3) != nil {
+		t.Error("KthAncestor returned wrong results")
+	}
+	if tree.Ancestor(four, &TreeNode{Val: 9}) != nil || tree.Depth(&TreeNode{}) != -1 {
+		t.Error("Nodes outside the tree should not be found")
+	}
+	if NewTreeLCA(nil).Ancestor(nil, nil) != nil {
+		t.Error("Empty tree should have no ancestors")
+	}
+}
@@ -0,0 +1,236 @@
+package dfs
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+)
+
+// === Bounded Path Enumeration ===
+
+// PathOptions restricts which simple paths are enumerated or counted.
+// Zero values mean no limit.
+type PathOptions struct {
+	MaxLength int   // Maximum number of edges in a path
+	MaxPaths  int   // Maximum number of paths to report (ignored when counting)
+	Required  []int // Vertices every path must pass through
+	Forbidden []int // Vertices no path may touch
+}
+
+// ErrNotDAG is returned by CountPathsDAG when the graph contains a cycle
+var ErrNotDAG = errors.New("graph contains a cycle")
+
+// EnumeratePaths streams every simple path from start to target to visit, using an
+// explicit stack in the same order as FindAllPaths. The slice passed to visit is
+// reused, so copy it to keep it. Returning false from visit stops the enumeration.
+// It returns the number of paths reported and ctx.Err() if ctx was cancelled.
+func EnumeratePaths(ctx context.Context, graph map[int][]int, start, target int, opts PathOptions, visit func(path []int) bool) (int, error) {
+	forbidden := make(map[int]bool, len(opts.Forbidden))
+	for _, node := range opts.Forbidden {
+		forbidden[node] = true
+	}
+	required := make(map[int]bool, len(opts.Required))
+	for _, node := range opts.Required {
+		required[node] = true
+	}
+	if forbidden[start] || forbidden[target] {
+		return 0, nil
+	}
+
+	type frame struct {
+		node, next int
+	}
+
+	count := 0
+	onPath := map[int]bool{start: true}
+	path := []int{start}
+	requiredSeen := 0
+	if required[start] {
+		requiredSeen++
+	}
+	callStack := []frame{{node: start}}
+
+	for steps := 0; len(callStack) > 0; steps++ {
+		if steps%1024 == 0 {
+			if err := ctx.Err(); err != nil {
+				return count, err
+			}
+		}
+
+		top := &callStack[len(callStack)-1]
+		node := top.node
+
+		// Report the path on arrival and never extend beyond the target
+		if node == target && top.next == 0 {
+			top.next = len(graph[node])
+			if requiredSeen == len(required) {
+				count++
+				if !visit(path) || count == opts.MaxPaths {
+					return count, nil
+				}
+			}
+		}
+
+		canExtend := opts.MaxLength == 0 || len(path)-1 < opts.MaxLength
+		if canExtend && top.next < len(graph[node]) {
+			neighbor := graph[node][top.next]
+			top.next++
+			if !onPath[neighbor] && !forbidden[neighbor] {
+				onPath[neighbor] = true
+				path = append(path, neighbor)
+				if required[neighbor] {
+					requiredSeen++
+				}
+				callStack = append(callStack, frame{node: neighbor})
+			}
+			continue
+		}
+
+		// Backtrack
+		onPath[node] = false
+		if required[node] {
+			requiredSeen--
+		}
+		path = path[:len(path)-1]
+		callStack = callStack[:len(callStack)-1]
+	}
+	return count, nil
+}
+
+// PathsChannel runs EnumeratePaths in a goroutine and sends a copy of every path on
+// the returned channel, which is closed when enumeration ends. Cancel ctx to stop
+// early; the goroutine exits without blocking on an abandoned channel.
+func PathsChannel(ctx context.Context, graph map[int][]int, start, target int, opts PathOptions) <-chan []int {
+	paths := make(chan []int)
+	go func() {
+		defer close(paths)
+		EnumeratePaths(ctx, graph, start, target, opts, func(path []int) bool {
+			select {
+			case paths <- append([]int(nil), path...):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+	return paths
+}
+
+// CountPathsDAG counts the paths from start to target in a DAG with dynamic
+// programming over a topological order, without enumerating them.
+// Required vertices are chained in topological order; MaxPaths is ignored.
+func CountPathsDAG(graph map[int][]int, start, target int, opts PathOptions) (*big.Int, error) {
+	if HasCycleDirected(graph) {
+		return nil, ErrNotDAG
+	}
+
+	forbidden := make(map[int]bool, len(opts.Forbidden))
+	for _, node := range opts.Forbidden {
+		forbidden[node] = true
+	}
+	if forbidden[start] || forbidden[target] {
+		return big.NewInt(0), nil
+	}
+
+	order := TopologicalSort(graph)
+	position := make(map[int]int, len(order))
+	for i, node := range order {
+		position[node] = i
+	}
+
+	// A DAG path visits its vertices in topological order, so split it at the
+	// required vertices and combine the counts of the segments
+	waypoints := []int{start}
+	required := append([]int(nil), opts.Required...)
+	sort.Slice(required, func(i, j int) bool { return position[required[i]] < position[required[j]] })
+	for _, node := range required {
+		if forbidden[node] {
+			return big.NewInt(0), nil
+		}
+		if node != start && node != target {
+			waypoints = append(waypoints, node)
+		}
+	}
+	waypoints = append(waypoints, target)
+
+	// Counts are bucketed by path length only when a length limit applies;
+	// otherwise every length shares bucket 0
+	maxLength := max(opts.MaxLength, 0)
+
+	// total[l] counts start -> current waypoint paths with l edges
+	total := newCounts(maxLength)
+	total[0].SetInt64(1)
+
+	for i := 1; i < len(waypoints); i++ {
+		from, to := waypoints[i-1], waypoints[i]
+		if position[from] > position[to] {
+			return big.NewInt(0), nil
+		}
+		segment := countSegment(graph, order, position, forbidden, from, to, maxLength)
+
+		combined := newCounts(maxLength)
+		for a, x := range total {
+			if x.Sign() == 0 {
+				continue
+			}
+			for b, y := range segment {
+				if a+b < len(combined) && y.Sign() != 0 {
+					combined[a+b].Add(combined[a+b], new(big.Int).Mul(x, y))
+				}
+			}
+		}
+		total = combined
+	}
+
+	result := big.NewInt(0)
+	for _, x := range total {
+		result.Add(result, x)
+	}
+	return result, nil
+}
+
+// countSegment counts from -> to paths by length, up to maxLength edges.
+// A maxLength of 0 counts paths of any length in a single bucket.
+func countSegment(graph map[int][]int, order []int, position map[int]int, forbidden map[int]bool, from, to, maxLength int) []*big.Int {
+	ways := map[int][]*big.Int{from: newCounts(maxLength)}
+	ways[from][0].SetInt64(1)
+
+	for _, node := range order[position[from]:] {
+		counts, ok := ways[node]
+		if !ok || node == to {
+			continue
+		}
+		for _, neighbor := range graph[node] {
+			if forbidden[neighbor] {
+				continue
+			}
+			if ways[neighbor] == nil {
+				ways[neighbor] = newCounts(maxLength)
+			}
+			if maxLength == 0 {
+				ways[neighbor][0].Add(ways[neighbor][0], counts[0])
+				continue
+			}
+			for l := 0; l < maxLength; l++ {
+				if counts[l].Sign() != 0 {
+					ways[neighbor][l+1].Add(ways[neighbor][l+1], counts[l])
+				}
+			}
+		}
+	}
+
+	if ways[to] == nil {
+		return newCounts(maxLength)
+	}
+	return ways[to]
+}
+
+// newCounts allocates zeroed path counts for lengths 0 to maxLength
+func newCounts(maxLength int) []*big.Int {
+	counts := make([]*big.Int, maxLength+1)
+	for l := range counts {
+		counts[l] = big.NewInt(0)
+	}
+	return counts
+}
@@ -0,0 +1,155 @@
+package dfs
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+// Test helper: a diamond chain 1 -> {2,3} -> 4 -> {5,6} -> 7 with a shortcut 1 -> 4
+func createDiamondGraph() map[int][]int {
+	return map[int][]int{
+		1: {2, 3, 4},
+		2: {4},
+		3: {4},
+		4: {5, 6},
+		5: {7},
+		6: {7},
+	}
+}
+
+func collectPaths(graph map[int][]int, start, target int, opts PathOptions) [][]int {
+	paths := [][]int{}
+	EnumeratePaths(context.Background(), graph, start, target, opts, func(path []int) bool {
+		paths = append(paths, append([]int(nil), path...))
+		return true
+	})
+	return paths
+}
+
+func TestEnumeratePaths(t *testing.T) {
+	graph := createDiamondGraph()
+
+	result := collectPaths(graph, 1, 7, PathOptions{})
+	expected := [][]int{
+		{1, 2, 4, 5, 7}, {1, 2, 4, 6, 7},
+		{1, 3, 4, 5, 7}, {1, 3, 4, 6, 7},
+		{1, 4, 5, 7}, {1, 4, 6, 7},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("EnumeratePaths failed. Expected %v, got %v", expected, result)
+	}
+
+	// Cycles are never followed twice
+	cyclic := map[int][]int{1: {2}, 2: {1, 3}, 3: {}}
+	if paths := collectPaths(cyclic, 1, 3, PathOptions{}); !reflect.DeepEqual(paths, [][]int{{1, 2, 3}}) {
+		t.Errorf("EnumeratePaths on cyclic graph = %v; want [[1 2 3]]", paths)
+	}
+}
+
+func TestEnumeratePathsOptions(t *testing.T) {
+	graph := createDiamondGraph()
+
+	tests := []struct {
+		name     string
+		opts     PathOptions
+		expected [][]int
+	}{
+		{"max length", PathOptions{MaxLength: 3}, [][]int{{1, 4, 5, 7}, {1, 4, 6, 7}}},
+		{"max paths", PathOptions{MaxPaths: 2}, [][]int{{1, 2, 4, 5, 7}, {1, 2, 4, 6, 7}}},
+		{"required", PathOptions{Required: []int{3, 6}}, [][]int{{1, 3, 4, 6, 7}}},
+		{"forbidden", PathOptions{Forbidden: []int{2, 3, 5}}, [][]int{{1, 4, 6, 7}}},
+		{"forbidden target", PathOptions{Forbidden: []int{7}}, [][]int{}},
+	}
+
+	for _, test := range tests {
+		if result := collectPaths(graph, 1, 7, test.opts); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, result)
+		}
+	}
+}
+
+func TestEnumeratePathsCancellation(t *testing.T) {
+	// A complete DAG on 30 vertices has 2^28 paths from 0 to 29
+	graph := make(map[int][]int)
+	for u := 0; u < 30; u++ {
+		for v := u + 1; v < 30; v++ {
+			graph[u] = append(graph[u], v)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	count, err := EnumeratePaths(ctx, graph, 0, 29, PathOptions{}, func(path []int) bool {
+		cancel()
+		return true
+	})
+	if err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if count == 0 || count > 1024 {
+		t.Errorf("Cancellation should stop enumeration promptly, got %d paths", count)
+	}
+}
+
+func TestPathsChannel(t *testing.T) {
+	paths := [][]int{}
+	for path := range PathsChannel(context.Background(), createDiamondGraph(), 1, 7, PathOptions{MaxLength: 3}) {
+		paths = append(paths, path)
+	}
+	expected := [][]int{{1, 4, 5, 7}, {1, 4, 6, 7}}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("PathsChannel failed. Expected %v, got %v", expected, paths)
+	}
+
+	// Cancelling lets the producer exit while paths are still pending
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := PathsChannel(ctx, createDiamondGraph(), 1, 7, PathOptions{})
+	<-ch
+	cancel()
+	for range ch {
+	}
+}
+
+func TestCountPathsDAG(t *testing.T) {
+	graph := createDiamondGraph()
+
+	tests := []struct {
+		name     string
+		opts     PathOptions
+		expected int64
+	}{
+		{"all", PathOptions{}, 6},
+		{"max length", PathOptions{MaxLength: 3}, 2},
+		{"required", PathOptions{Required: []int{6, 3}}, 1},
+		{"forbidden", PathOptions{Forbidden: []int{4}}, 0},
+		{"required and length", PathOptions{Required: []int{2}, MaxLength: 4}, 2},
+	}
+
+	for _, test := range tests {
+		count, err := CountPathsDAG(graph, 1, 7, test.opts)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", test.name, err)
+		}
+		if count.Cmp(big.NewInt(test.expected)) != 0 {
+			t.Errorf("%s: expected %d paths, got %s", test.name, test.expected, count)
+		}
+		if enumerated := len(collectPaths(graph, 1, 7, test.opts)); int64(enumerated) != test.expected {
+			t.Errorf("%s: enumeration found %d paths, count says %d", test.name, enumerated, test.expected)
+		}
+	}
+
+	// Counting handles far more paths than could be enumerated
+	chain := make(map[int][]int)
+	for u := 0; u < 100; u++ {
+		chain[u] = []int{u + 1, u + 1}
+	}
+	count, _ := CountPathsDAG(chain, 0, 100, PathOptions{})
+	if count.Cmp(new(big.Int).Lsh(big.NewInt(1), 100)) != 0 {
+		t.Errorf("Doubled chain should have 2^100 paths, got %s", count)
+	}
+
+	if _, err := CountPathsDAG(map[int][]int{1: {2}, 2: {1}}, 1, 2, PathOptions{}); err != ErrNotDAG {
+		t.Errorf("CountPathsDAG on cyclic graph should return ErrNotDAG, got %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package dfs
+
+// === Classic Backtracking Puzzles ===
+
+// NQueens places n queens on an n x n board so that none attack each other.
+// Each solution lists the queen's column for every row. A positive limit caps the
+// number of solutions returned.
+func NQueens(n, limit int) [][]int {
+	solutions := [][]int{}
+	newQueensSolver(n, limit).Solve(func(columns []int) bool {
+		solutions = append(solutions, append([]int(nil), columns...))
+		return true
+	})
+	return solutions
+}
+
+// CountNQueens counts the solutions of the n-queens problem
+func CountNQueens(n int) int {
+	return newQueensSolver(n, 0).Count()
+}
+
+// newQueensSolver assigns one queen per row, tracking attacked columns and diagonals
+func newQueensSolver(n, limit int) *Backtracker {
+	columns := make([]bool, n)
+	diagonals := make([]bool, 2*n)
+	antiDiagonals := make([]bool, 2*n)
+
+	return &Backtracker{
+		Variables:    n,
+		MaxSolutions: limit,
+		Candidates: func(row int) []int {
+			free := []int{}
+			for col := 0; col < n; col++ {
+				if !columns[col] && !diagonals[row-col+n] && !antiDiagonals[row+col] {
+					free = append(free, col)
+				}
+			}
+			return free
+		},
+		Choose: func(row, col int) {
+			columns[col], diagonals[row-col+n], antiDiagonals[row+col] = true, true, true
+		},
+		Unchoose: func(row, col int) {
+			columns[col], diagonals[row-col+n], antiDiagonals[row+col] = false, false, false
+		},
+	}
+}
+
+// SolveSudoku fills the empty (zero) cells of a 9x9 Sudoku grid, choosing the most
+// constrained cell first. It returns the solved grid and whether a solution exists.
+func SolveSudoku(grid [9][9]int) ([9][9]int, bool) {
+	var rows, cols, boxes [9][10]bool
+	for r := 0; r < 9; r++ {
+		for c := 0; c < 9; c++ {
+			if v := grid[r][c]; v != 0 {
+				if rows[r][v] || cols[c][v] || boxes[r/3*3+c/3][v] {
+					return grid, false // the givens already conflict
+				}
+				rows[r][v], cols[c][v], boxes[r/3*3+c/3][v] = true, true, true
+			}
+		}
+	}
+
+	// Variables are the empty cells only
+	empty := [][2]int{}
+	for r := 0; r < 9; r++ {
+		for c := 0; c < 9; c++ {
+			if grid[r][c] == 0 {
+				empty = append(empty, [2]int{r, c})
+			}
+		}
+	}
+
+	set := func(cell int, v int, on bool) {
+		r, c := empty[cell][0], empty[cell][1]
+		rows[r][v], cols[c][v], boxes[r/3*3+c/3][v] = on, on, on
+	}
+
+	solver := &Backtracker{
+		Variables:    len(empty),
+		MRV:          true,
+		MaxSolutions: 1,
+		Candidates: func(cell int) []int {
+			r, c := empty[cell][0], empty[cell][1]
+			values := []int{}
+			for v := 1; v <= 9; v++ {
+				if !rows[r][v] && !cols[c][v] && !boxes[r/3*3+c/3][v] {
+					values = append(values, v)
+				}
+			}
+			return values
+		},
+		Choose:   func(cell, v int) { set(cell, v, true) },
+		Unchoose: func(cell, v int) { set(cell, v, false) },
+	}
+
+	solved := grid
+	found := solver.Solve(func(values []int) bool {
+		for cell, v := range values {
+			solved[empty[cell][0]][empty[cell][1]] = v
+		}
+		return false
+	}) > 0
+	return solved, found
+}
+
+// SubsetSums returns the subsets of the non-negative numbers that add up to target,
+// as index lists in increasing order. Branches whose partial sum overshoots the
+// target, or that cannot reach it with the remaining numbers, are pruned.
+// A positive limit caps the number of subsets returned.
+func SubsetSums(numbers []int, target, limit int) [][]int {
+	suffix := make([]int, len(numbers)+1)
+	for i := len(numbers) - 1; i >= 0; i-- {
+		suffix[i] = suffix[i+1] + numbers[i]
+	}
+
+	sum := 0
+	solver := &Backtracker{
+		Variables:    len(numbers),
+		MaxSolutions: limit,
+		Candidates: func(i int) []int {
+			return []int{1, 0} // include, then exclude
+		},
+		Accept: func(i, take int) bool {
+			next := sum + take*numbers[i]
+			return next <= target && next+suffix[i+1] >= target
+		},
+		Choose:   func(i, take int) { sum += take * numbers[i] },
+		Unchoose: func(i, take int) { sum -= take * numbers[i] },
+	}
+
+	subsets := [][]int{}
+	solver.Solve(func(take []int) bool {
+		subset := []int{}
+		for i, t := range take {
+			if t == 1 {
+				subset = append(subset, i)
+			}
+		}
+		subsets = append(subsets, subset)
+		return true
+	})
+	return subsets
+}
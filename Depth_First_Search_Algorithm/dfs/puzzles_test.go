@@ -0,0 +1,79 @@
+package dfs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNQueens(t *testing.T) {
+	counts := map[int]int{1: 1, 2: 0, 3: 0, 4: 2, 6: 4, 8: 92}
+	for n, expected := range counts {
+		if count := CountNQueens(n); count != expected {
+			t.Errorf("CountNQueens(%d) = %d; want %d", n, count, expected)
+		}
+	}
+
+	solutions := NQueens(4, 0)
+	expected := [][]int{{1, 3, 0, 2}, {2, 0, 3, 1}}
+	if !reflect.DeepEqual(solutions, expected) {
+		t.Errorf("NQueens(4) = %v; want %v", solutions, expected)
+	}
+
+	if solutions := NQueens(8, 3); len(solutions) != 3 {
+		t.Errorf("NQueens(8, 3) should return 3 solutions, got %d", len(solutions))
+	}
+}
+
+func TestSolveSudoku(t *testing.T) {
+	puzzle := [9][9]int{
+		{5, 3, 0, 0, 7, 0, 0, 0, 0},
+		{6, 0, 0, 1, 9, 5, 0, 0, 0},
+		{0, 9, 8, 0, 0, 0, 0, 6, 0},
+		{8, 0, 0, 0, 6, 0, 0, 0, 3},
+		{4, 0, 0, 8, 0, 3, 0, 0, 1},
+		{7, 0, 0, 0, 2, 0, 0, 0, 6},
+		{0, 6, 0, 0, 0, 0, 2, 8, 0},
+		{0, 0, 0, 4, 1, 9, 0, 0, 5},
+		{0, 0, 0, 0, 8, 0, 0, 7, 9},
+	}
+	expected := [9][9]int{
+		{5, 3, 4, 6, 7, 8, 9, 1, 2},
+		{6, 7, 2, 1, 9, 5, 3, 4, 8},
+		{1, 9, 8, 3, 4, 2, 5, 6, 7},
+		{8, 5, 9, 7, 6, 1, 4, 2, 3},
+		{4, 2, 6, 8, 5, 3, 7, 9, 1},
+		{7, 1, 3, 9, 2, 4, 8, 5, 6},
+		{9, 6, 1, 5, 3, 7, 2, 8, 4},
+		{2, 8, 7, 4, 1, 9, 6, 3, 5},
+		{3, 4, 5, 2, 8, 6, 1, 7, 9},
+	}
+
+	solved, ok := SolveSudoku(puzzle)
+	if !ok || solved != expected {
+		t.Errorf("SolveSudoku failed. Expected %v, got %v (ok=%t)", expected, solved, ok)
+	}
+
+	// Conflicting givens have no solution
+	puzzle[0][2] = 5
+	if _, ok := SolveSudoku(puzzle); ok {
+		t.Error("SolveSudoku should reject conflicting givens")
+	}
+}
+
+func TestSubsetSums(t *testing.T) {
+	numbers := []int{3, 34, 4, 12, 5, 2}
+
+	result := SubsetSums(numbers, 9, 0)
+	expected := [][]int{{0, 2, 5}, {2, 4}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("SubsetSums(9) = %v; want %v", result, expected)
+	}
+
+	if result := SubsetSums(numbers, 100, 0); len(result) != 0 {
+		t.Errorf("SubsetSums(100) should be empty, got %v", result)
+	}
+
+	if result := SubsetSums(numbers, 9, 1); len(result) != 1 {
+		t.Errorf("SubsetSums with limit 1 should return one subset, got %v", result)
+	}
+}
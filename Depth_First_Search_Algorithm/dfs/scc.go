@@ -0,0 +1,223 @@
+package dfs
+
+import "sort"
+
+// === Strongly Connected Components ===
+
+// TarjanSCC finds the strongly connected components of a directed graph with an
+// iterative version of Tarjan's algorithm. Components are returned in reverse
+// topological order: a component only has edges to components listed before it.
+// Vertices inside each component are sorted.
+func TarjanSCC(graph map[int][]int) [][]int {
+	index := make(map[int]int)
+	lowLink := make(map[int]int)
+	onStack := make(map[int]bool)
+	stack := []int{}
+	components := [][]int{}
+	counter := 0
+
+	type frame struct {
+		node, next int
+	}
+
+	for _, root := range sortedVertices(graph) {
+		if _, seen := index[root]; seen {
+			continue
+		}
+
+		index[root], lowLink[root] = counter, counter
+		counter++
+		stack = append(stack, root)
+		onStack[root] = true
+		callStack := []frame{{node: root}}
+
+		for len(callStack) > 0 {
+			top := &callStack[len(callStack)-1]
+			node := top.node
+
+			if top.next < len(graph[node]) {
+				neighbor := graph[node][top.next]
+				top.next++
+
+				if _, seen := index[neighbor]; !seen {
+					// Descend into the neighbor
+					index[neighbor], lowLink[neighbor] = counter, counter
+					counter++
+					stack = append(stack, neighbor)
+					onStack[neighbor] = true
+					callStack = append(callStack, frame{node: neighbor})
+				} else if onStack[neighbor] && index[neighbor] < lowLink[node] {
+					lowLink[node] = index[neighbor]
+				}
+				continue
+			}
+
+			// All edges explored: pop the frame and report a root's component
+			callStack = callStack[:len(callStack)-1]
+			if len(callStack) > 0 {
+				parent := callStack[len(callStack)-1].node
+				if lowLink[node] < lowLink[parent] {
+					lowLink[parent] = lowLink[node]
+				}
+			}
+
+			if lowLink[node] == index[node] {
+				component := []int{}
+				for {
+					member := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[member] = false
+					component = append(component, member)
+					if member == node {
+						break
+					}
+				}
+				sort.Ints(component)
+				components = append(components, component)
+			}
+		}
+	}
+	return components
+}
+
+// KosarajuSCC finds the strongly connected components of a directed graph with two
+// iterative DFS passes. Components are returned in reverse topological order, like
+// TarjanSCC, with the vertices inside each component sorted.
+func KosarajuSCC(graph map[int][]int) [][]int {
+	vertices := sortedVertices(graph)
+
+	// First pass: record vertices by finish time
+	visited := make(map[int]bool)
+	finished := []int{}
+	for _, root := range vertices {
+		if !visited[root] {
+			finished = append(finished, postOrderFrom(graph, root, visited)...)
+		}
+	}
+
+	// Second pass on the transpose, in decreasing finish time
+	reversed := Transpose(graph)
+	visited = make(map[int]bool)
+	components := [][]int{}
+	for i := len(finished) - 1; i >= 0; i-- {
+		root := finished[i]
+		if visited[root] {
+			continue
+		}
+		component := postOrderFrom(reversed, root, visited)
+		sort.Ints(component)
+		components = append(components, component)
+	}
+
+	// The second pass yields components in topological order
+	for i, j := 0, len(components)-1; i < j; i, j = i+1, j-1 {
+		components[i], components[j] = components[j], components[i]
+	}
+	return components
+}
+
+// Condensation is the DAG obtained by contracting every strongly connected component
+type Condensation struct {
+	Components  [][]int       // Components in reverse topological order
+	ComponentOf map[int]int   // Vertex -> index into Components
+	DAG         map[int][]int // Edges between component indices, sorted and deduplicated
+}
+
+// Condense builds the condensation DAG of a directed graph. Every component index
+// appears as a key of DAG, so TopologicalSort(c.DAG) orders all components even when
+// the original graph has cycles.
+func Condense(graph map[int][]int) *Condensation {
+	components := TarjanSCC(graph)
+	componentOf := make(map[int]int)
+	for i, component := range components {
+		for _, node := range component {
+			componentOf[node] = i
+		}
+	}
+
+	dag := make(map[int][]int, len(components))
+	for i := range components {
+		dag[i] = []int{}
+	}
+	for node, neighbors := range graph {
+		from := componentOf[node]
+		for _, neighbor := range neighbors {
+			to := componentOf[neighbor]
+			if from != to && !contains(dag[from], to) {
+				dag[from] = append(dag[from], to)
+			}
+		}
+	}
+	for _, targets := range dag {
+		sort.Ints(targets)
+	}
+
+	return &Condensation{
+		Components:  components,
+		ComponentOf: componentOf,
+		DAG:         dag,
+	}
+}
+
+// Transpose returns a copy of the graph with every edge reversed.
+// Vertices without incoming edges are kept as keys with no neighbors.
+func Transpose(graph map[int][]int) map[int][]int {
+	reversed := make(map[int][]int)
+	for _, node := range sortedVertices(graph) {
+		reversed[node] = []int{}
+	}
+	for _, node := range sortedVertices(graph) {
+		for _, neighbor := range graph[node] {
+			reversed[neighbor] = append(reversed[neighbor], node)
+		}
+	}
+	return reversed
+}
+
+// postOrderFrom returns the unvisited vertices reachable from root in DFS finish order
+func postOrderFrom(graph map[int][]int, root int, visited map[int]bool) []int {
+	type frame struct {
+		node, next int
+	}
+
+	order := []int{}
+	visited[root] = true
+	callStack := []frame{{node: root}}
+
+	for len(callStack) > 0 {
+		top := &callStack[len(callStack)-1]
+		if top.next < len(graph[top.node]) {
+			neighbor := graph[top.node][top.next]
+			top.next++
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				callStack = append(callStack, frame{node: neighbor})
+			}
+			continue
+		}
+		order = append(order, top.node)
+		callStack = callStack[:len(callStack)-1]
+	}
+	return order
+}
+
+// sortedVertices returns every vertex of the graph, including those that only
+// appear as neighbors, in ascending order
+func sortedVertices(graph map[int][]int) []int {
+	seen := make(map[int]bool)
+	vertices := []int{}
+	add := func(node int) {
+		if !seen[node] {
+			seen[node] = true
+			vertices = append(vertices, node)
+		}
+	}
+	for node, neighbors := range graph {
+		add(node)
+		for _, neighbor := range neighbors {
+			add(neighbor)
+		}
+	}
+	sort.Ints(vertices)
+	return vertices
+}
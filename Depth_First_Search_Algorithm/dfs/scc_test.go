@@ -0,0 +1,118 @@
+package dfs
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Test helper: two cycles joined by an edge, plus a tail vertex
+//
+//	1 -> 2 -> 3 -> 1,  3 -> 4,  4 -> 5 -> 4,  5 -> 6
+func createSCCGraph() map[int][]int {
+	return map[int][]int{
+		1: {2},
+		2: {3},
+		3: {1, 4},
+		4: {5},
+		5: {4, 6},
+	}
+}
+
+func TestTarjanSCC(t *testing.T) {
+	result := TarjanSCC(createSCCGraph())
+	expected := [][]int{{6}, {4, 5}, {1, 2, 3}}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("TarjanSCC failed. Expected %v, got %v", expected, result)
+	}
+}
+
+func TestKosarajuSCC(t *testing.T) {
+	result := KosarajuSCC(createSCCGraph())
+	expected := [][]int{{6}, {4, 5}, {1, 2, 3}}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("KosarajuSCC failed. Expected %v, got %v", expected, result)
+	}
+}
+
+func TestSCCReverseTopologicalOrder(t *testing.T) {
+	graph := map[int][]int{
+		1: {2, 5},
+		2: {3},
+		3: {2, 4},
+		5: {6},
+		6: {5, 4},
+		7: {7},
+	}
+
+	for name, scc := range map[string]func(map[int][]int) [][]int{
+		"TarjanSCC":   TarjanSCC,
+		"KosarajuSCC": KosarajuSCC,
+	} {
+		components := scc(graph)
+		position := make(map[int]int)
+		for i, component := range components {
+			for _, node := range component {
+				position[node] = i
+			}
+		}
+
+		if len(position) != 7 {
+			t.Errorf("%s should cover 7 vertices, got %d", name, len(position))
+		}
+
+		// Every edge must point to the same or an earlier component
+		for node, neighbors := range graph {
+			for _, neighbor := range neighbors {
+				if position[neighbor] > position[node] {
+					t.Errorf("%s: edge %d -> %d points to a later component", name, node, neighbor)
+				}
+			}
+		}
+	}
+}
+
+func TestCondense(t *testing.T) {
+	c := Condense(createSCCGraph())
+
+	expectedDAG := map[int][]int{0: {}, 1: {0}, 2: {1}}
+	if !reflect.DeepEqual(c.DAG, expectedDAG) {
+		t.Errorf("Condense DAG failed. Expected %v, got %v", expectedDAG, c.DAG)
+	}
+
+	if c.ComponentOf[1] != c.ComponentOf[3] || c.ComponentOf[1] == c.ComponentOf[4] {
+		t.Errorf("Condense ComponentOf is inconsistent: %v", c.ComponentOf)
+	}
+
+	// The condensation of a cyclic graph can be topologically sorted
+	order := TopologicalSort(c.DAG)
+	if !reflect.DeepEqual(order, []int{2, 1, 0}) {
+		t.Errorf("TopologicalSort of condensation = %v; want [2 1 0]", order)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	result := Transpose(map[int][]int{1: {2, 3}, 2: {3}})
+	expected := map[int][]int{1: {}, 2: {1}, 3: {1, 2}}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Transpose failed. Expected %v, got %v", expected, result)
+	}
+}
+
+func TestSCCDeepChain(t *testing.T) {
+	// Both implementations are iterative, so a long cycle does not grow the goroutine stack
+	graph := make(map[int][]int)
+	n := 200000
+	for i := 0; i < n; i++ {
+		graph[i] = []int{(i + 1) % n}
+	}
+
+	if components := TarjanSCC(graph); len(components) != 1 || len(components[0]) != n {
+		t.Errorf("TarjanSCC on a %d-cycle should find one component", n)
+	}
+	if components := KosarajuSCC(graph); len(components) != 1 || len(components[0]) != n {
+		t.Errorf("KosarajuSCC on a %d-cycle should find one component", n)
+	}
+}
@@ -0,0 +1,140 @@
+package dfs
+
+import "sort"
+
+// === Incremental Connected Components ===
+
+// ComponentTracker maintains the connected components of an undirected graph
+// that only grows. It is a disjoint-set forest with union by size and path
+// halving, so AddEdge and the queries run in near-constant amortized time
+// instead of a full DFS per question.
+type ComponentTracker struct {
+	index  map[int]int // Vertex id -> slot
+	ids    []int       // Slot -> vertex id
+	parent []int       // Slot -> parent slot
+	size   []int       // Component size, valid for root slots only
+	count  int
+}
+
+// NewComponentTracker creates an empty tracker
+func NewComponentTracker() *ComponentTracker {
+	return &ComponentTracker{index: make(map[int]int)}
+}
+
+// NewComponentTrackerFromGraph seeds a tracker with every vertex and edge of graph,
+// treating the edges as undirected
+func NewComponentTrackerFromGraph(graph map[int][]int) *ComponentTracker {
+	t := NewComponentTracker()
+	for _, node := range sortedVertices(graph) {
+		t.AddVertex(node)
+	}
+	for node, neighbors := range graph {
+		for _, neighbor := range neighbors {
+			t.AddEdge(node, neighbor)
+		}
+	}
+	return t
+}
+
+// AddVertex adds an isolated vertex and reports whether it was new
+func (t *ComponentTracker) AddVertex(v int) bool {
+	if _, ok := t.index[v]; ok {
+		return false
+	}
+	slot := len(t.ids)
+	t.index[v] = slot
+	t.ids = append(t.ids, v)
+	t.parent = append(t.parent, slot)
+	t.size = append(t.size, 1)
+	t.count++
+	return true
+}
+
+// AddEdge connects u and v, adding either vertex if needed, and reports whether
+// the edge merged two previously separate components
+func (t *ComponentTracker) AddEdge(u, v int) bool {
+	t.AddVertex(u)
+	t.AddVertex(v)
+	a, b := t.root(t.index[u]), t.root(t.index[v])
+	if a == b {
+		return false
+	}
+	if t.size[a] < t.size[b] {
+		a, b = b, a
+	}
+	t.parent[b] = a
+	t.size[a] += t.size[b]
+	t.count--
+	return true
+}
+
+// ComponentOf returns a representative vertex of v's component, or false if v is
+// unknown. Two vertices share a component exactly when their representatives are
+// equal; a representative may change after later AddEdge calls.
+func (t *ComponentTracker) ComponentOf(v int) (int, bool) {
+	slot, ok := t.index[v]
+	if !ok {
+		return 0, false
+	}
+	return t.ids[t.root(slot)], true
+}
+
+// Connected reports whether u and v are in the same component
+func (t *ComponentTracker) Connected(u, v int) bool {
+	a, ok1 := t.index[u]
+	b, ok2 := t.index[v]
+	return ok1 && ok2 && t.root(a) == t.root(b)
+}
+
+// ComponentSize returns the number of vertices in v's component, or 0 if v is unknown
+func (t *ComponentTracker) ComponentSize(v int) int {
+	slot, ok := t.index[v]
+	if !ok {
+		return 0
+	}
+	return t.size[t.root(slot)]
+}
+
+// ComponentCount returns the number of components
+func (t *ComponentTracker) ComponentCount() int {
+	return t.count
+}
+
+// VertexCount returns the number of vertices added so far
+func (t *ComponentTracker) VertexCount() int {
+	return len(t.ids)
+}
+
+// GetConnectedComponents returns a snapshot of every component. Each component
+// is sorted, and components are ordered by their smallest vertex.
+func (t *ComponentTracker) GetConnectedComponents() [][]int {
+	position := make(map[int]int, t.count) // Root slot -> index in components
+	components := make([][]int, 0, t.count)
+	for slot := range t.ids {
+		root := t.root(slot)
+		i, ok := position[root]
+		if !ok {
+			i = len(components)
+			position[root] = i
+			components = append(components, make([]int, 0, t.size[root]))
+		}
+		components[i] = append(components[i], t.ids[slot])
+	}
+
+	for _, component := range components {
+		sort.Ints(component)
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i][0] < components[j][0]
+	})
+	return components
+}
+
+// root finds the root slot, halving the path on the way up
+func (t *ComponentTracker) root(slot int) int {
+	for t.parent[slot] != slot {
+		t.parent[slot] = t.parent[t.parent[slot]]
+		slot = t.parent[slot]
+	}
+	return slot
+}
@@ -0,0 +1,83 @@
+package dfs
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestComponentTrackerIncremental(t *testing.T) {
+	tracker := NewComponentTracker()
+	if !tracker.AddVertex(1) || tracker.AddVertex(1) {
+		t.Error("AddVertex should only report new vertices")
+	}
+	if !tracker.AddEdge(1, 2) || !tracker.AddEdge(3, 4) {
+		t.Error("Edges between separate components should merge them")
+	}
+	if tracker.ComponentCount() != 2 || tracker.VertexCount() != 4 {
+		t.Errorf("Expected 2 components over 4 vertices, got %d over %d",
+			tracker.ComponentCount(), tracker.VertexCount())
+	}
+
+	if !tracker.AddEdge(2, 3) || tracker.AddEdge(1, 4) {
+		t.Error("Only the first edge joining {1,2} and {3,4} should merge")
+	}
+	tracker.AddVertex(9)
+
+	if tracker.ComponentCount() != 2 {
+		t.Errorf("ComponentCount = %d; want 2", tracker.ComponentCount())
+	}
+	if tracker.ComponentSize(4) != 4 || tracker.ComponentSize(9) != 1 || tracker.ComponentSize(7) != 0 {
+		t.Error("ComponentSize returned wrong sizes")
+	}
+	if !tracker.Connected(1, 4) || tracker.Connected(1, 9) || tracker.Connected(1, 7) {
+		t.Error("Connected returned wrong results")
+	}
+
+	a, _ := tracker.ComponentOf(1)
+	b, _ := tracker.ComponentOf(3)
+	if a != b {
+		t.Errorf("Vertices 1 and 3 should share a representative, got %d and %d", a, b)
+	}
+	if _, ok := tracker.ComponentOf(7); ok {
+		t.Error("Unknown vertex should have no component")
+	}
+
+	expected := [][]int{{1, 2, 3, 4}, {9}}
+	if got := tracker.GetConnectedComponents(); fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("GetConnectedComponents = %v; want %v", got, expected)
+	}
+}
+
+func TestComponentTrackerMatchesDFS(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	graph := make(map[int][]int)
+	tracker := NewComponentTracker()
+
+	for batch := 0; batch < 20; batch++ {
+		for i := 0; i < 10; i++ {
+			u, v := rng.Intn(100), rng.Intn(100)
+			graph[u] = append(graph[u], v)
+			if _, ok := graph[v]; !ok {
+				graph[v] = []int{}
+			}
+			tracker.AddEdge(u, v)
+		}
+
+		if got, want := tracker.ComponentCount(), CountComponents(graph); got != want {
+			t.Fatalf("batch %d: ComponentCount = %d; CountComponents = %d", batch, got, want)
+		}
+		for _, component := range GetConnectedComponents(graph) {
+			for _, node := range component {
+				if !tracker.Connected(component[0], node) || tracker.ComponentSize(node) != len(component) {
+					t.Fatalf("batch %d: vertex %d disagrees with DFS component %v", batch, node, component)
+				}
+			}
+		}
+	}
+
+	seeded := NewComponentTrackerFromGraph(graph)
+	if fmt.Sprint(seeded.GetConnectedComponents()) != fmt.Sprint(tracker.GetConnectedComponents()) {
+		t.Error("Tracker seeded from the graph should match the incremental one")
+	}
+}
@@ -0,0 +1,149 @@
+package dfs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// === 2-SAT ===
+
+// Literal is a boolean variable or its negation
+type Literal struct {
+	Var     string
+	Negated bool
+}
+
+// Not returns the negation of the literal
+func (l Literal) Not() Literal {
+	return Literal{Var: l.Var, Negated: !l.Negated}
+}
+
+// String renders the literal as "x" or "!x"
+func (l Literal) String() string {
+	if l.Negated {
+		return "!" + l.Var
+	}
+	return l.Var
+}
+
+// TwoSAT collects clauses of the form (a OR b) over named boolean variables
+type TwoSAT struct {
+	index       map[string]int
+	names       []string
+	implication map[int][]int
+}
+
+// NewTwoSAT creates an empty 2-SAT instance
+func NewTwoSAT() *TwoSAT {
+	return &TwoSAT{
+		index:       make(map[string]int),
+		implication: make(map[int][]int),
+	}
+}
+
+// AddClause adds the constraint (a OR b) as the implications !a -> b and !b -> a
+func (s *TwoSAT) AddClause(a, b Literal) {
+	s.addImplication(a.Not(), b)
+	s.addImplication(b.Not(), a)
+}
+
+// Require forces a literal to be true
+func (s *TwoSAT) Require(a Literal) {
+	s.AddClause(a, a)
+}
+
+// Implies adds the constraint a -> b
+func (s *TwoSAT) Implies(a, b Literal) {
+	s.AddClause(a.Not(), b)
+}
+
+// Conflict forbids a and b from both being true
+func (s *TwoSAT) Conflict(a, b Literal) {
+	s.AddClause(a.Not(), b.Not())
+}
+
+// UnsatisfiableError reports a variable that is forced both ways. Cycle is the chain
+// of implications x -> ... -> !x -> ... -> x that proves the contradiction.
+type UnsatisfiableError struct {
+	Variable string
+	Cycle    []Literal
+}
+
+// Error describes the contradiction
+func (e *UnsatisfiableError) Error() string {
+	steps := make([]string, len(e.Cycle))
+	for i, l := range e.Cycle {
+		steps[i] = l.String()
+	}
+	return fmt.Sprintf("2-SAT unsatisfiable: variable %q implies its own negation (%s)",
+		e.Variable, strings.Join(steps, " -> "))
+}
+
+// Solve decides satisfiability through the strongly connected components of the
+// implication graph and returns a satisfying assignment for every variable.
+// If the instance is unsatisfiable it returns an *UnsatisfiableError.
+func (s *TwoSAT) Solve() (map[string]bool, error) {
+	graph := make(map[int][]int, 2*len(s.names))
+	for node := 0; node < 2*len(s.names); node++ {
+		graph[node] = s.implication[node]
+	}
+
+	// TarjanSCC lists components in reverse topological order
+	component := make(map[int]int)
+	for i, members := range TarjanSCC(graph) {
+		for _, node := range members {
+			component[node] = i
+		}
+	}
+
+	assignment := make(map[string]bool, len(s.names))
+	for i, name := range s.names {
+		positive, negative := 2*i, 2*i+1
+		if component[positive] == component[negative] {
+			return nil, &UnsatisfiableError{
+				Variable: name,
+				Cycle:    s.implicationCycle(graph, positive, negative),
+			}
+		}
+		// Pick the literal that comes later in topological order
+		assignment[name] = component[positive] < component[negative]
+	}
+	return assignment, nil
+}
+
+// implicationCycle joins the paths positive -> negative and negative -> positive
+func (s *TwoSAT) implicationCycle(graph map[int][]int, positive, negative int) []Literal {
+	forward := FindPath(graph, positive, negative)
+	backward := FindPath(graph, negative, positive)
+
+	cycle := []Literal{}
+	for _, node := range append(forward, backward[1:]...) {
+		cycle = append(cycle, s.literal(node))
+	}
+	return cycle
+}
+
+// addImplication records the edge a -> b in the implication graph
+func (s *TwoSAT) addImplication(a, b Literal) {
+	from, to := s.node(a), s.node(b)
+	s.implication[from] = append(s.implication[from], to)
+}
+
+// node maps a literal to its vertex: 2i for variable i, 2i+1 for its negation
+func (s *TwoSAT) node(l Literal) int {
+	i, ok := s.index[l.Var]
+	if !ok {
+		i = len(s.names)
+		s.index[l.Var] = i
+		s.names = append(s.names, l.Var)
+	}
+	if l.Negated {
+		return 2*i + 1
+	}
+	return 2 * i
+}
+
+// literal maps a vertex of the implication graph back to its literal
+func (s *TwoSAT) literal(node int) Literal {
+	return Literal{Var: s.names[node/2], Negated: node%2 == 1}
+}
@@ -0,0 +1,108 @@
+package dfs
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestTwoSATSatisfiable(t *testing.T) {
+	s := NewTwoSAT()
+	darkMode := Literal{Var: "dark_mode"}
+	legacyUI := Literal{Var: "legacy_ui"}
+	newNav := Literal{Var: "new_nav"}
+
+	s.Require(darkMode)
+	s.Conflict(darkMode, legacyUI)
+	s.AddClause(legacyUI, newNav)
+
+	assignment, err := s.Solve()
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	expected := map[string]bool{"dark_mode": true, "legacy_ui": false, "new_nav": true}
+	for name, value := range expected {
+		if assignment[name] != value {
+			t.Errorf("%s = %t; want %t", name, assignment[name], value)
+		}
+	}
+}
+
+func TestTwoSATUnsatisfiable(t *testing.T) {
+	s := NewTwoSAT()
+	x, y := Literal{Var: "x"}, Literal{Var: "y"}
+	s.Implies(x, y)
+	s.Implies(x, y.Not())
+	s.Implies(x.Not(), y)
+	s.Implies(y, x)
+
+	_, err := s.Solve()
+	var unsat *UnsatisfiableError
+	if !errors.As(err, &unsat) {
+		t.Fatalf("Expected *UnsatisfiableError, got %v", err)
+	}
+	if unsat.Variable != "x" {
+		t.Errorf("Conflicting variable = %q; want \"x\"", unsat.Variable)
+	}
+
+	// The certificate is a closed chain of implications through x and !x
+	cycle := unsat.Cycle
+	if cycle[0] != x || cycle[len(cycle)-1] != x {
+		t.Errorf("Certificate should start and end at x, got %v", cycle)
+	}
+	sawNegation := false
+	for _, l := range cycle {
+		sawNegation = sawNegation || l == x.Not()
+	}
+	if !sawNegation {
+		t.Errorf("Certificate should pass through !x, got %v", cycle)
+	}
+	for i := 1; i < len(cycle); i++ {
+		if !contains(s.implication[s.node(cycle[i-1])], s.node(cycle[i])) {
+			t.Errorf("Certificate step %v -> %v is not an implication", cycle[i-1], cycle[i])
+		}
+	}
+}
+
+func TestTwoSATMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+
+	for trial := 0; trial < 200; trial++ {
+		n := 4
+		clauses := [][2]Literal{}
+		s := NewTwoSAT()
+		for i := 0; i < 7; i++ {
+			a := Literal{Var: fmt.Sprint(rng.Intn(n)), Negated: rng.Intn(2) == 0}
+			b := Literal{Var: fmt.Sprint(rng.Intn(n)), Negated: rng.Intn(2) == 0}
+			clauses = append(clauses, [2]Literal{a, b})
+			s.AddClause(a, b)
+		}
+
+		satisfies := func(assignment map[string]bool) bool {
+			for _, c := range clauses {
+				if assignment[c[0].Var] == c[0].Negated && assignment[c[1].Var] == c[1].Negated {
+					return false
+				}
+			}
+			return true
+		}
+
+		bruteForce := false
+		for mask := 0; mask < 1<<n; mask++ {
+			assignment := map[string]bool{}
+			for v := 0; v < n; v++ {
+				assignment[fmt.Sprint(v)] = mask&(1<<v) != 0
+			}
+			bruteForce = bruteForce || satisfies(assignment)
+		}
+
+		assignment, err := s.Solve()
+		if (err == nil) != bruteForce {
+			t.Fatalf("Clauses %v: Solve error %v, brute force satisfiable %t", clauses, err, bruteForce)
+		}
+		if err == nil && !satisfies(assignment) {
+			t.Fatalf("Clauses %v: assignment %v does not satisfy them", clauses, assignment)
+		}
+	}
+}
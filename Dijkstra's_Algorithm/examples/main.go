@@ -6,7 +6,7 @@ import (
 )
 
 func main() {
-	fmt.Println("=== Dijkstra's Algorithm Demonstrations ===\n")
+	fmt.Print("=== Dijkstra's Algorithm Demonstrations ===\n\n")
 
 	// Example 1: Simple Graph
 	/*
@@ -73,4 +73,28 @@ func main() {
 		fmt.Println("No path exists from 0 to 2")
 	}
 	fmt.Printf("Distance: %d\n", distance3)
+
+	// Example 4: Betweenness Centrality
+	fmt.Println("\nExample 4: Weighted Betweenness Centrality on Example 1")
+	scores, err := g1.Betweenness(true)
+	if err != nil {
+		fmt.Println("Error:", err)
+	}
+	for v, score := range scores {
+		fmt.Printf("Vertex %d: %.3f\n", v, score)
+	}
+
+	// Example 5: Route Planning (TSP and Hamiltonian paths)
+	fmt.Println("\nExample 5: Visiting every site of Example 1 exactly once")
+	tour, cost, _ := g1.TSP(0)
+	fmt.Printf("Optimal tour (Held-Karp): %v (Cost: %d)\n", tour, cost)
+	sites := g1.DistanceMatrix()
+	tour, cost, _ = graph.HeldKarpTour(sites, 0)
+	fmt.Printf("Optimal tour over road distances: %v (Cost: %d)\n", tour, cost)
+	route, cost, _ := graph.HeldKarpPath(sites, 0, -1)
+	fmt.Printf("Shortest route visiting all sites from 0: %v (Cost: %d)\n", route, cost)
+	greedy, greedyCost, _ := graph.NearestNeighborTour(sites, 0)
+	improved, improvedCost := graph.TwoOpt(sites, greedy)
+	fmt.Printf("Nearest neighbour: %v (Cost: %d), after 2-opt: %v (Cost: %d)\n",
+		greedy, greedyCost, improved, improvedCost)
 }
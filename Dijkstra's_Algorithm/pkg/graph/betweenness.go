@@ -0,0 +1,108 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+	"math/rand"
+)
+
+// Betweenness computes weighted betweenness centrality using Brandes' algorithm.
+// Edges are treated as directed and weights must be positive: a zero-weight edge
+// lets equal-distance vertices precede each other, so shortest-path counts are
+// undefined and an error is returned instead.
+// When normalized is true the scores are divided by (n-1)(n-2).
+func (g *Graph) Betweenness(normalized bool) ([]float64, error) {
+	sources := make([]int, len(g.Adj))
+	for i := range sources {
+		sources[i] = i
+	}
+	return g.betweenness(sources, normalized)
+}
+
+// ApproxBetweenness estimates weighted betweenness centrality from a random
+// sample of pivot sources, scaling contributions by n/pivots.
+// A pivots value of at least n computes the exact scores. Like Betweenness it
+// rejects weights that are not positive.
+func (g *Graph) ApproxBetweenness(pivots int, seed int64, normalized bool) ([]float64, error) {
+	n := len(g.Adj)
+	if pivots >= n || pivots <= 0 {
+		return g.Betweenness(normalized)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	return g.betweenness(rng.Perm(n)[:pivots], normalized)
+}
+
+// betweenness accumulates Brandes' dependency scores from the given sources
+func (g *Graph) betweenness(sources []int, normalized bool) ([]float64, error) {
+	n := len(g.Adj)
+	for u := range g.Adj {
+		for _, edge := range g.Adj[u] {
+			if edge.Weight <= 0 {
+				return nil, fmt.Errorf("edge %d -> %d has weight %d; betweenness needs positive weights", u, edge.To, edge.Weight)
+			}
+		}
+	}
+	centrality := make([]float64, n)
+
+	for _, s := range sources {
+		dist := make([]int, n)
+		sigma := make([]float64, n)
+		preds := make([][]int, n)
+		settled := make([]bool, n)
+		for i := range dist {
+			dist[i] = -1
+		}
+		dist[s] = 0
+		sigma[s] = 1
+
+		// Dijkstra, recording vertices in the order they are settled
+		order := make([]int, 0, n)
+		pq := make(PriorityQueue, 0)
+		heap.Push(&pq, &Item{Node: s, Distance: 0})
+
+		for pq.Len() > 0 {
+			u := heap.Pop(&pq).(*Item).Node
+			if settled[u] {
+				continue
+			}
+			settled[u] = true
+			order = append(order, u)
+
+			for _, edge := range g.Adj[u] {
+				v := edge.To
+				newDist := dist[u] + edge.Weight
+				switch {
+				case dist[v] == -1 || newDist < dist[v]:
+					dist[v] = newDist
+					sigma[v] = sigma[u]
+					preds[v] = append(preds[v][:0], u)
+					heap.Push(&pq, &Item{Node: v, Distance: newDist})
+				case newDist == dist[v]:
+					sigma[v] += sigma[u]
+					preds[v] = append(preds[v], u)
+				}
+			}
+		}
+
+		// Back-propagate dependencies from the farthest vertices
+		delta := make([]float64, n)
+		for i := len(order) - 1; i >= 0; i-- {
+			w := order[i]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				centrality[w] += delta[w]
+			}
+		}
+	}
+
+	scale := float64(n) / float64(max(len(sources), 1))
+	if normalized && n > 2 {
+		scale /= float64((n - 1) * (n - 2))
+	}
+	for i := range centrality {
+		centrality[i] *= scale
+	}
+	return centrality, nil
+}
@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Test helper: reports whether two score vectors agree to within rounding
+func closeScores(got, want []float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestBetweennessPrefersCheaperPath(t *testing.T) {
+	// 0 -> 1 -> 2 costs 2 and beats the direct edge 0 -> 2 costing 10
+	g := NewGraph(3)
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(0, 2, 10)
+
+	if got, err := g.Betweenness(false); err != nil || !closeScores(got, []float64{0, 1, 0}) {
+		t.Errorf("Betweenness = %v, %v; want [0 1 0]", got, err)
+	}
+	if got, err := g.Betweenness(true); err != nil || !closeScores(got, []float64{0, 0.5, 0}) {
+		t.Errorf("Betweenness(normalized) = %v, %v; want [0 0.5 0]", got, err)
+	}
+}
+
+func TestBetweennessSplitsTies(t *testing.T) {
+	// Three shortest routes from 0 to 3 cost 2: via 1, via 2 and the direct edge
+	g := NewGraph(4)
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 3, 1)
+	g.AddEdge(0, 2, 1)
+	g.AddEdge(2, 3, 1)
+	g.AddEdge(0, 3, 2)
+
+	third := 1.0 / 3
+	if got, err := g.Betweenness(false); err != nil || !closeScores(got, []float64{0, third, third, 0}) {
+		t.Errorf("Betweenness = %v, %v; want [0 1/3 1/3 0]", got, err)
+	}
+	if got, err := g.Betweenness(true); err != nil || !closeScores(got, []float64{0, third / 6, third / 6, 0}) {
+		t.Errorf("Betweenness(normalized) = %v, %v; want [0 1/18 1/18 0]", got, err)
+	}
+}
+
+func TestApproxBetweenness(t *testing.T) {
+	// Star with centre 0: every leaf source adds 4 to the centre, the centre adds nothing
+	g := NewGraph(6)
+	for v := 1; v < 6; v++ {
+		g.AddUndirectedEdge(0, v, 1)
+	}
+	if got, err := g.Betweenness(false); err != nil || fmt.Sprint(got) != "[20 0 0 0 0 0]" {
+		t.Errorf("Betweenness = %v, %v; want [20 0 0 0 0 0]", got, err)
+	}
+
+	// Seed 1 samples three leaves, 3*4 scaled by 6/3; seed 2 includes the centre, 2*4*2
+	if got, err := g.ApproxBetweenness(3, 1, false); err != nil || fmt.Sprint(got) != "[24 0 0 0 0 0]" {
+		t.Errorf("ApproxBetweenness(3, 1) = %v, %v; want [24 0 0 0 0 0]", got, err)
+	}
+	if got, err := g.ApproxBetweenness(3, 2, false); err != nil || fmt.Sprint(got) != "[16 0 0 0 0 0]" {
+		t.Errorf("ApproxBetweenness(3, 2) = %v, %v; want [16 0 0 0 0 0]", got, err)
+	}
+	a, _ := g.ApproxBetweenness(3, 7, true)
+	b, _ := g.ApproxBetweenness(3, 7, true)
+	if fmt.Sprint(a) != fmt.Sprint(b) {
+		t.Errorf("ApproxBetweenness is not reproducible: %v then %v", a, b)
+	}
+	if got, err := g.ApproxBetweenness(6, 1, false); err != nil || fmt.Sprint(got) != "[20 0 0 0 0 0]" {
+		t.Errorf("ApproxBetweenness with every pivot = %v, %v; want the exact scores", got, err)
+	}
+}
+
+func TestBetweennessRejectsNonPositiveWeights(t *testing.T) {
+	g := NewGraph(3)
+	g.AddEdge(0, 1, 0)
+	g.AddEdge(1, 2, 1)
+	if got, err := g.Betweenness(false); err == nil || got != nil {
+		t.Errorf("Betweenness with a zero-weight edge = %v, %v; want an error", got, err)
+	}
+	if got, err := g.ApproxBetweenness(1, 1, false); err == nil || got != nil {
+		t.Errorf("ApproxBetweenness with a zero-weight edge = %v, %v; want an error", got, err)
+	}
+}
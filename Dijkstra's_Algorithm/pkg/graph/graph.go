@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"container/heap"
 	"math"
 )
 
@@ -54,11 +55,11 @@ func (g *Graph) Dijkstra(start int) ([]int, []int) {
 		Node:     start,
 		Distance: 0,
 	}
-	pq.Push(startItem)
+	heap.Push(&pq, startItem)
 
 	for len(pq) > 0 {
 		// Get vertex with minimum distance
-		u := pq.Pop().(*Item)
+		u := heap.Pop(&pq).(*Item)
 		if visited[u.Node] {
 			continue
 		}
@@ -76,7 +77,7 @@ func (g *Graph) Dijkstra(start int) ([]int, []int) {
 						Node:     v,
 						Distance: newDist,
 					}
-					pq.Push(item)
+					heap.Push(&pq, item)
 				}
 			}
 		}
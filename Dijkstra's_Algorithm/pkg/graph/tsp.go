@@ -0,0 +1,327 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// NoEdge marks a missing edge in a weight matrix, matching the unreachable
+// distance reported by Dijkstra
+const NoEdge = math.MaxInt32
+
+// MaxHeldKarpVertices is the largest instance the exact solvers accept.
+// Held-Karp needs O(2^n * n) memory and O(2^n * n^2) time.
+const MaxHeldKarpVertices = 20
+
+// ErrNoHamiltonian is returned when no route visits every vertex exactly once
+var ErrNoHamiltonian = errors.New("no Hamiltonian route exists")
+
+// WeightMatrix converts the graph to an n x n matrix of edge weights.
+// Parallel edges keep the cheapest weight, missing edges are NoEdge and the
+// diagonal is 0.
+func (g *Graph) WeightMatrix() [][]int {
+	n := len(g.Adj)
+	w := make([][]int, n)
+	for u := range w {
+		w[u] = make([]int, n)
+		for v := range w[u] {
+			if u != v {
+				w[u][v] = NoEdge
+			}
+		}
+		for _, edge := range g.Adj[u] {
+			if edge.To != u && edge.Weight < w[u][edge.To] {
+				w[u][edge.To] = edge.Weight
+			}
+		}
+	}
+	return w
+}
+
+// DistanceMatrix returns the shortest-path distance between every pair of vertices,
+// with NoEdge for unreachable pairs. Solving a tour over this matrix lets the route
+// pass through vertices again on the way between two stops.
+func (g *Graph) DistanceMatrix() [][]int {
+	w := make([][]int, len(g.Adj))
+	for u := range w {
+		w[u], _ = g.Dijkstra(u)
+	}
+	return w
+}
+
+// TSP finds a minimum-cost tour over the graph's edges with HeldKarpTour
+func (g *Graph) TSP(start int) ([]int, int, error) {
+	return HeldKarpTour(g.WeightMatrix(), start)
+}
+
+// HamiltonianPath finds a minimum-cost Hamiltonian path over the graph's edges
+// with HeldKarpPath
+func (g *Graph) HamiltonianPath(start, end int) ([]int, int, error) {
+	return HeldKarpPath(g.WeightMatrix(), start, end)
+}
+
+// ApproxTSP builds a nearest-neighbour tour and improves it with 2-opt
+func (g *Graph) ApproxTSP(start int) ([]int, int, error) {
+	w := g.WeightMatrix()
+	tour, _, err := NearestNeighborTour(w, start)
+	if err != nil {
+		return nil, 0, err
+	}
+	tour, cost := TwoOpt(w, tour)
+	return tour, cost, nil
+}
+
+// HeldKarpTour solves the travelling salesman problem exactly with bitmask dynamic
+// programming. The tour starts and ends at start, e.g. [0 2 1 3 0].
+func HeldKarpTour(w [][]int, start int) ([]int, int, error) {
+	n := len(w)
+	if start == -1 {
+		return nil, 0, fmt.Errorf("a tour needs a start vertex")
+	}
+	if err := checkHeldKarp(n, start, start); err != nil {
+		return nil, 0, err
+	}
+	if n == 1 {
+		return []int{start, start}, 0, nil
+	}
+
+	others := make([]int, 0, n-1)
+	for v := 0; v < n; v++ {
+		if v != start {
+			others = append(others, v)
+		}
+	}
+	startCost := make([]int, len(others))
+	endCost := make([]int, len(others))
+	for i, v := range others {
+		startCost[i], endCost[i] = w[start][v], w[v][start]
+	}
+
+	order, cost, ok := heldKarp(w, others, startCost, endCost)
+	if !ok {
+		return nil, 0, ErrNoHamiltonian
+	}
+	tour := append([]int{start}, order...)
+	return append(tour, start), cost, nil
+}
+
+// HeldKarpPath finds a minimum-cost path that visits every vertex exactly once.
+// Pass -1 for start or end to let the solver choose that endpoint.
+func HeldKarpPath(w [][]int, start, end int) ([]int, int, error) {
+	n := len(w)
+	if err := checkHeldKarp(n, start, end); err != nil {
+		return nil, 0, err
+	}
+	if start != -1 && start == end && n > 1 {
+		return nil, 0, ErrNoHamiltonian
+	}
+
+	others := make([]int, 0, n)
+	for v := 0; v < n; v++ {
+		if v != start {
+			others = append(others, v)
+		}
+	}
+	if n == 0 {
+		return []int{}, 0, nil
+	}
+	if len(others) == 0 {
+		return []int{start}, 0, nil
+	}
+
+	startCost := make([]int, len(others))
+	endCost := make([]int, len(others))
+	for i, v := range others {
+		if start != -1 {
+			startCost[i] = w[start][v]
+		}
+		if end != -1 && v != end {
+			endCost[i] = NoEdge
+		}
+	}
+
+	order, cost, ok := heldKarp(w, others, startCost, endCost)
+	if !ok {
+		return nil, 0, ErrNoHamiltonian
+	}
+	if start != -1 {
+		order = append([]int{start}, order...)
+	}
+	return order, cost, nil
+}
+
+// heldKarp orders others to minimise startCost + path weight + endCost.
+// dp[mask*m+i] is the cheapest way to visit exactly the vertices in mask, ending at others[i].
+func heldKarp(w [][]int, others, startCost, endCost []int) ([]int, int, bool) {
+	m := len(others)
+	full := 1<<m - 1
+	dp := make([]int, (full+1)*m)
+	prev := make([]int8, (full+1)*m)
+	for i := range dp {
+		dp[i] = math.MaxInt
+	}
+	for i := range others {
+		if startCost[i] != NoEdge {
+			dp[(1<<i)*m+i] = startCost[i]
+			prev[(1<<i)*m+i] = -1
+		}
+	}
+
+	for mask := 1; mask <= full; mask++ {
+		for i := 0; i < m; i++ {
+			cost := dp[mask*m+i]
+			if cost == math.MaxInt {
+				continue
+			}
+			row := w[others[i]]
+			for j := 0; j < m; j++ {
+				if mask&(1<<j) != 0 || row[others[j]] == NoEdge {
+					continue
+				}
+				next := (mask|1<<j)*m + j
+				if candidate := cost + row[others[j]]; candidate < dp[next] {
+					dp[next] = candidate
+					prev[next] = int8(i)
+				}
+			}
+		}
+	}
+
+	best, last := math.MaxInt, -1
+	for i := range others {
+		if dp[full*m+i] == math.MaxInt || endCost[i] == NoEdge {
+			continue
+		}
+		if total := dp[full*m+i] + endCost[i]; total < best {
+			best, last = total, i
+		}
+	}
+	if last == -1 {
+		return nil, 0, false
+	}
+
+	order := make([]int, m)
+	for mask, i := full, last; i != -1; {
+		order[bits.OnesCount(uint(mask))-1] = others[i]
+		i, mask = int(prev[mask*m+i]), mask&^(1<<i)
+	}
+	return order, best, true
+}
+
+// NearestNeighborTour builds a tour from start by always moving to the closest
+// unvisited vertex in O(n^2). It fails if it reaches a vertex with no edge to an
+// unvisited one, even when a tour exists.
+func NearestNeighborTour(w [][]int, start int) ([]int, int, error) {
+	n := len(w)
+	if start < 0 || start >= n {
+		return nil, 0, fmt.Errorf("start vertex %d out of range [0, %d)", start, n)
+	}
+
+	visited := make([]bool, n)
+	visited[start] = true
+	tour := []int{start}
+	for current := start; len(tour) < n; {
+		next := -1
+		for v := 0; v < n; v++ {
+			if !visited[v] && w[current][v] != NoEdge && (next == -1 || w[current][v] < w[current][next]) {
+				next = v
+			}
+		}
+		if next == -1 {
+			return nil, 0, fmt.Errorf("nearest-neighbour tour stuck at vertex %d after %d stops", current, len(tour))
+		}
+		visited[next] = true
+		tour = append(tour, next)
+		current = next
+	}
+	if n > 1 && w[tour[n-1]][start] == NoEdge {
+		return nil, 0, fmt.Errorf("nearest-neighbour tour cannot return from vertex %d to %d", tour[n-1], start)
+	}
+	tour = append(tour, start)
+	return tour, routeCost(w, tour), nil
+}
+
+// TwoOpt repeatedly reverses a segment of a closed tour (such as [0 2 1 3 0]) while
+// that shortens it, and returns the improved tour and its cost. Asymmetric weights
+// are handled by pricing the reversed segment in full.
+func TwoOpt(w [][]int, tour []int) ([]int, int) {
+	route := append([]int(nil), tour...)
+	symmetric := isSymmetric(w)
+
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < len(route)-3; i++ {
+			for j := i + 2; j < len(route)-1; j++ {
+				a, b, c, d := route[i], route[i+1], route[j], route[j+1]
+				if w[a][c] == NoEdge || w[b][d] == NoEdge {
+					continue
+				}
+				delta := w[a][c] + w[b][d] - w[a][b] - w[c][d]
+				if !symmetric {
+					delta += segmentDelta(w, route[i+1:j+1])
+				}
+				if delta < 0 {
+					reverseSegment(route[i+1 : j+1])
+					improved = true
+				}
+			}
+		}
+	}
+	return route, routeCost(w, route)
+}
+
+// checkHeldKarp validates the instance size and optional endpoints
+func checkHeldKarp(n, start, end int) error {
+	if n > MaxHeldKarpVertices {
+		return fmt.Errorf("%d vertices exceeds the Held-Karp limit of %d", n, MaxHeldKarpVertices)
+	}
+	for _, v := range []int{start, end} {
+		if v < -1 || v >= n {
+			return fmt.Errorf("vertex %d out of range [0, %d)", v, n)
+		}
+	}
+	return nil
+}
+
+// segmentDelta returns how much reversing the segment changes the cost of its internal edges
+func segmentDelta(w [][]int, segment []int) int {
+	delta := 0
+	for k := 0; k+1 < len(segment); k++ {
+		u, v := segment[k], segment[k+1]
+		if w[v][u] == NoEdge {
+			return NoEdge
+		}
+		delta += w[v][u] - w[u][v]
+	}
+	return delta
+}
+
+// routeCost sums the weights along a route
+func routeCost(w [][]int, route []int) int {
+	cost := 0
+	for k := 0; k+1 < len(route); k++ {
+		cost += w[route[k]][route[k+1]]
+	}
+	return cost
+}
+
+// isSymmetric reports whether w[u][v] == w[v][u] for every pair
+func isSymmetric(w [][]int) bool {
+	for u := range w {
+		for v := 0; v < u; v++ {
+			if w[u][v] != w[v][u] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// reverseSegment reverses a slice in place
+func reverseSegment(segment []int) {
+	for i, j := 0, len(segment)-1; i < j; i, j = i+1, j-1 {
+		segment[i], segment[j] = segment[j], segment[i]
+	}
+}
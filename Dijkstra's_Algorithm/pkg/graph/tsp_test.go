@@ -0,0 +1,207 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// Test helper: random n x n weight matrix with roughly one missing edge in five
+func randomMatrix(rng *rand.Rand, n int, symmetric bool) [][]int {
+	w := make([][]int, n)
+	for u := range w {
+		w[u] = make([]int, n)
+	}
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			switch {
+			case u == v:
+			case symmetric && v < u:
+				w[u][v] = w[v][u]
+			case rng.Intn(5) == 0:
+				w[u][v] = NoEdge
+			default:
+				w[u][v] = 1 + rng.Intn(20)
+			}
+		}
+	}
+	return w
+}
+
+// Test helper: calls visit with every permutation of vertices
+func permutations(vertices []int, visit func([]int)) {
+	var permute func(k int)
+	permute = func(k int) {
+		if k == len(vertices) {
+			visit(vertices)
+			return
+		}
+		for i := k; i < len(vertices); i++ {
+			vertices[k], vertices[i] = vertices[i], vertices[k]
+			permute(k + 1)
+			vertices[k], vertices[i] = vertices[i], vertices[k]
+		}
+	}
+	permute(0)
+}
+
+// Test helper: reports whether every step of route is an existing edge
+func usesEdges(w [][]int, route []int) bool {
+	for k := 0; k+1 < len(route); k++ {
+		if w[route[k]][route[k+1]] == NoEdge {
+			return false
+		}
+	}
+	return true
+}
+
+// bruteForce returns the cheapest route accepted by keep, and whether one exists
+func bruteForce(w [][]int, keep func(order []int) []int) (int, bool) {
+	vertices := make([]int, len(w))
+	for i := range vertices {
+		vertices[i] = i
+	}
+	best, found := 0, false
+	permutations(vertices, func(order []int) {
+		route := keep(order)
+		if route == nil || !usesEdges(w, route) {
+			return
+		}
+		if cost := routeCost(w, route); !found || cost < best {
+			best, found = cost, true
+		}
+	})
+	return best, found
+}
+
+func TestHeldKarpTourMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		n := 2 + rng.Intn(6)
+		w := randomMatrix(rng, n, trial%2 == 0)
+		start := rng.Intn(n)
+
+		want, exists := bruteForce(w, func(order []int) []int {
+			if order[0] != start {
+				return nil
+			}
+			return append(append([]int(nil), order...), start)
+		})
+		tour, cost, err := HeldKarpTour(w, start)
+		if !exists {
+			if !errors.Is(err, ErrNoHamiltonian) {
+				t.Fatalf("trial %d: expected ErrNoHamiltonian, got %v %v", trial, tour, err)
+			}
+			continue
+		}
+		if err != nil || cost != want || routeCost(w, tour) != cost || !usesEdges(w, tour) ||
+			len(tour) != n+1 || tour[0] != start || tour[n] != start {
+			t.Fatalf("trial %d: HeldKarpTour = %v, %d, %v; want cost %d", trial, tour, cost, err, want)
+		}
+	}
+}
+
+func TestHeldKarpPathMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 200; trial++ {
+		n := 2 + rng.Intn(6)
+		w := randomMatrix(rng, n, trial%2 == 0)
+		a, b := rng.Intn(n), rng.Intn(n)
+
+		for _, ends := range [][2]int{{-1, -1}, {a, -1}, {-1, b}, {a, b}} {
+			start, end := ends[0], ends[1]
+			if start != -1 && start == end {
+				continue
+			}
+			want, exists := bruteForce(w, func(order []int) []int {
+				if (start != -1 && order[0] != start) || (end != -1 && order[n-1] != end) {
+					return nil
+				}
+				return order
+			})
+			path, cost, err := HeldKarpPath(w, start, end)
+			if !exists {
+				if !errors.Is(err, ErrNoHamiltonian) {
+					t.Fatalf("trial %d %v: expected ErrNoHamiltonian, got %v %v", trial, ends, path, err)
+				}
+				continue
+			}
+			if err != nil || cost != want || routeCost(w, path) != cost || !usesEdges(w, path) || len(path) != n ||
+				(start != -1 && path[0] != start) || (end != -1 && path[n-1] != end) {
+				t.Fatalf("trial %d %v: HeldKarpPath = %v, %d, %v; want cost %d", trial, ends, path, cost, err, want)
+			}
+		}
+	}
+}
+
+func TestHeldKarpErrors(t *testing.T) {
+	// 0 -> 1 -> 2 with no way back to 0
+	g := NewGraph(3)
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	if _, _, err := g.TSP(0); !errors.Is(err, ErrNoHamiltonian) {
+		t.Errorf("TSP on a one-way chain: got %v; want ErrNoHamiltonian", err)
+	}
+	if _, _, err := g.HamiltonianPath(2, -1); !errors.Is(err, ErrNoHamiltonian) {
+		t.Errorf("HamiltonianPath from the sink: got %v; want ErrNoHamiltonian", err)
+	}
+	if path, cost, err := g.HamiltonianPath(-1, -1); err != nil || fmt.Sprint(path) != "[0 1 2]" || cost != 2 {
+		t.Errorf("HamiltonianPath = %v, %d, %v; want [0 1 2], 2", path, cost, err)
+	}
+
+	oversized := make([][]int, MaxHeldKarpVertices+1)
+	for u := range oversized {
+		oversized[u] = make([]int, len(oversized))
+	}
+	if _, _, err := HeldKarpTour(oversized, 0); err == nil || errors.Is(err, ErrNoHamiltonian) {
+		t.Errorf("HeldKarpTour should reject %d vertices, got %v", len(oversized), err)
+	}
+	if _, _, err := HeldKarpPath(oversized, -1, -1); err == nil || errors.Is(err, ErrNoHamiltonian) {
+		t.Errorf("HeldKarpPath should reject %d vertices, got %v", len(oversized), err)
+	}
+}
+
+func TestTwoOptNeverIncreasesCost(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 200; trial++ {
+		n := 3 + rng.Intn(10)
+		w := randomMatrix(rng, n, trial%2 == 0)
+		tour, cost, err := NearestNeighborTour(w, 0)
+		if err != nil {
+			continue
+		}
+		if !usesEdges(w, tour) || routeCost(w, tour) != cost {
+			t.Fatalf("trial %d: NearestNeighborTour returned inconsistent tour %v with cost %d", trial, tour, cost)
+		}
+
+		improved, improvedCost := TwoOpt(w, tour)
+		if improvedCost > cost || routeCost(w, improved) != improvedCost || !usesEdges(w, improved) {
+			t.Fatalf("trial %d: TwoOpt turned %v (%d) into %v (%d)", trial, tour, cost, improved, improvedCost)
+		}
+		if n <= MaxHeldKarpVertices {
+			if _, optimal, err := HeldKarpTour(w, 0); err != nil || improvedCost < optimal {
+				t.Fatalf("trial %d: TwoOpt cost %d beats the optimum %d (%v)", trial, improvedCost, optimal, err)
+			}
+		}
+	}
+}
+
+func TestDistanceMatrix(t *testing.T) {
+	// The cheap two-hop route 0 -> 1 -> 2 must beat the direct edge 0 -> 2
+	g := NewGraph(4)
+	g.AddEdge(0, 1, 1)
+	g.AddEdge(1, 2, 1)
+	g.AddEdge(0, 2, 10)
+	g.AddEdge(2, 3, 1)
+
+	if got := g.DistanceMatrix()[0]; fmt.Sprint(got) != "[0 1 2 3]" {
+		t.Errorf("DistanceMatrix()[0] = %v; want [0 1 2 3]", got)
+	}
+	if got := g.DistanceMatrix()[3][0]; got != NoEdge {
+		t.Errorf("Unreachable pair should be NoEdge, got %d", got)
+	}
+	if got := g.WeightMatrix()[0]; fmt.Sprint(got) != fmt.Sprint([]int{0, 1, 10, NoEdge}) {
+		t.Errorf("WeightMatrix()[0] = %v", got)
+	}
+}
@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math/rand"
+	"os"
 	"topological/pkg/graph"
 )
 
 func main() {
-	fmt.Println("=== Topological Sort Algorithm Demonstrations ===\n")
+	fmt.Print("=== Topological Sort Algorithm Demonstrations ===\n\n")
 
 	// Example 1: Course Prerequisites
 	fmt.Println("Example 1: Course Prerequisites")
@@ -82,11 +86,83 @@ func main() {
 	g3.AddEdge(1, 2)
 	g3.AddEdge(2, 0) // Creates a cycle
 
-	order3, hasCycle := g3.TopologicalSort()
-	if hasCycle {
+	order3, err := g3.TopologicalOrder()
+	if cycleErr, ok := err.(*graph.CycleError); ok {
 		fmt.Println("Detected cyclic dependencies!")
-		fmt.Println("Cannot establish a valid order.")
+		jobs := []string{"fetch", "build", "deploy"}
+		fmt.Println("Cycle:", cycleErr.Format(func(v int) string { return jobs[v] }))
+		fmt.Println("Cannot order vertices:", cycleErr.Unorderable)
 	} else {
 		fmt.Println("Order:", order3)
 	}
+
+	// Example 4: Kahn's Algorithm with Tie-Breaks
+	fmt.Println("\nExample 4: Kahn's Algorithm with Deterministic Tie-Breaks")
+	/*
+	   5 → 2 → 3 → 1
+	   ↓       ↑
+	   0 ← 4 --+
+	*/
+	g4 := graph.NewGraph(6)
+	g4.AddEdge(5, 2)
+	g4.AddEdge(5, 0)
+	g4.AddEdge(4, 0)
+	g4.AddEdge(4, 3)
+	g4.AddEdge(2, 3)
+	g4.AddEdge(3, 1)
+
+	smallest, _ := g4.KahnSort(graph.SmallestID)
+	fmt.Println("Smallest id first:", smallest)
+	fifo, _ := g4.KahnSort(graph.InsertionOrder)
+	fmt.Println("Insertion order:  ", fifo)
+	urgent := map[int]int{3: 10, 2: 5}
+	prioritized, _ := g4.KahnSortByPriority(func(v int) int { return urgent[v] })
+	fmt.Println("Urgent first:     ", prioritized)
+
+	// Example 5: Parallel Layers and Concurrent Execution
+	fmt.Println("\nExample 5: Parallel Execution of the Example 4 Graph")
+	layers, _ := g4.Layers()
+	for i, layer := range layers {
+		fmt.Printf("Layer %d (run concurrently): %v\n", i, layer)
+	}
+	tasks := make([]graph.Task, g4.V)
+	tasks[2] = func(context.Context) error { return errors.New("compile error") }
+	status, err := g4.Execute(context.Background(), tasks, 2, graph.ContinueOnError)
+	fmt.Println("Execution error:", err)
+	for v, s := range status {
+		fmt.Printf("Task %d: %s\n", v, s)
+	}
+
+	// Example 6: Critical Path Method
+	fmt.Println("\nExample 6: Critical Path of a Small Project")
+	/*
+	   0 (Design, 3) → 1 (Backend, 5) → 3 (Integrate, 2) → 4 (Release, 1)
+	        ↓                               ↑
+	        +------→ 2 (Frontend, 4) ------+
+	*/
+	project := graph.NewGraph(5)
+	project.AddEdge(0, 1)
+	project.AddEdge(0, 2)
+	project.AddEdge(1, 3)
+	project.AddEdge(2, 3)
+	project.AddEdge(3, 4)
+
+	schedule, _ := project.CriticalPath([]float64{3, 5, 4, 2, 1})
+	stepNames := []string{"Design", "Backend", "Frontend", "Integrate", "Release"}
+	for v := range schedule.Tasks {
+		schedule.Tasks[v].Name = stepNames[v]
+	}
+	fmt.Println("Project duration:", schedule.Duration)
+	fmt.Println("Critical path:", schedule.CriticalPath)
+	schedule.WriteCSV(os.Stdout)
+
+	// Example 7: Every Valid Order
+	fmt.Println("\nExample 7: All Valid Build Orders of Example 2")
+	for _, order := range g2.TopologicalSorts(0) {
+		fmt.Println(order)
+	}
+	total, _ := g4.CountTopologicalSorts()
+	fmt.Println("Number of valid orders of the Example 4 graph:", total)
+	sample, _ := g4.UniformTopologicalSort(rand.New(rand.NewSource(42)))
+	fmt.Println("Uniformly sampled order:", sample)
 }
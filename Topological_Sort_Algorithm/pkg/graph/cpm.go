@@ -0,0 +1,151 @@
+package graph
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// TaskTimes holds the critical path method figures for one vertex
+type TaskTimes struct {
+	Vertex   int     `json:"vertex"`
+	Name     string  `json:"name,omitempty"`
+	Duration float64 `json:"duration"`
+	ES       float64 `json:"earliestStart"`
+	EF       float64 `json:"earliestFinish"`
+	LS       float64 `json:"latestStart"`
+	LF       float64 `json:"latestFinish"`
+	Slack    float64 `json:"slack"`
+	Critical bool    `json:"critical"`
+}
+
+// Schedule is the result of the critical path method
+type Schedule struct {
+	Tasks        []TaskTimes `json:"tasks"`        // Indexed by vertex
+	Duration     float64     `json:"duration"`     // Total project duration
+	CriticalPath []int       `json:"criticalPath"` // One longest chain of zero-slack tasks
+}
+
+// CriticalPath schedules the graph as a project in which vertex v is a task taking
+// durations[v] and an edge u -> v means v starts after u finishes.
+// Returns a *CycleError if the graph has a cycle.
+func (g *Graph) CriticalPath(durations []float64) (*Schedule, error) {
+	return g.CriticalPathWithLags(durations, nil)
+}
+
+// CriticalPathWithLags is CriticalPath with an extra delay on each edge: v may
+// start lag(u, v) after u finishes. Edge-only durations can be modelled with zero
+// task durations. A nil lag means no delays.
+func (g *Graph) CriticalPathWithLags(durations []float64, lag func(from, to int) float64) (*Schedule, error) {
+	if len(durations) != g.V {
+		return nil, fmt.Errorf("got %d durations for %d vertices", len(durations), g.V)
+	}
+	for v, d := range durations {
+		if d < 0 || math.IsNaN(d) {
+			return nil, fmt.Errorf("vertex %d has invalid duration %v", v, d)
+		}
+	}
+	if lag == nil {
+		lag = func(int, int) float64 { return 0 }
+	}
+	order, hasCycle := g.KahnSort(SmallestID)
+	if hasCycle {
+		return nil, g.checkCycle()
+	}
+
+	s := &Schedule{Tasks: make([]TaskTimes, g.V)}
+	for v := range s.Tasks {
+		s.Tasks[v] = TaskTimes{Vertex: v, Duration: durations[v]}
+	}
+
+	// Forward pass: earliest start is the latest finish among predecessors
+	for _, u := range order {
+		t := &s.Tasks[u]
+		t.EF = t.ES + t.Duration
+		s.Duration = math.Max(s.Duration, t.EF)
+		for _, v := range g.Adj[u] {
+			s.Tasks[v].ES = math.Max(s.Tasks[v].ES, t.EF+lag(u, v))
+		}
+	}
+
+	// Backward pass: latest finish is the earliest latest start among successors
+	for i := len(order) - 1; i >= 0; i-- {
+		u := order[i]
+		t := &s.Tasks[u]
+		t.LF = s.Duration
+		for _, v := range g.Adj[u] {
+			t.LF = math.Min(t.LF, s.Tasks[v].LS-lag(u, v))
+		}
+		t.LS = t.LF - t.Duration
+		t.Slack = t.LS - t.ES
+	}
+
+	// Tolerate rounding from the subtractions in the backward pass
+	epsilon := 1e-9 * math.Max(1, s.Duration)
+	for v := range s.Tasks {
+		if t := &s.Tasks[v]; math.Abs(t.Slack) <= epsilon {
+			t.LS, t.LF, t.Slack = t.ES, t.EF, 0
+			t.Critical = true
+		}
+	}
+	s.CriticalPath = g.criticalChain(s, lag, epsilon)
+	return s, nil
+}
+
+// criticalChain follows zero-slack tasks whose start is forced by their
+// predecessor's finish, from a task starting at time zero to one ending the project
+func (g *Graph) criticalChain(s *Schedule, lag func(from, to int) float64, epsilon float64) []int {
+	path := []int{}
+	current := -1
+	for v, t := range s.Tasks {
+		if t.Critical && t.ES <= epsilon {
+			current = v
+			break
+		}
+	}
+	for current != -1 {
+		path = append(path, current)
+		next := -1
+		for _, w := range g.Adj[current] {
+			forced := math.Abs(s.Tasks[w].ES-(s.Tasks[current].EF+lag(current, w))) <= epsilon
+			if s.Tasks[w].Critical && forced && (next == -1 || w < next) {
+				next = w
+			}
+		}
+		current = next
+	}
+	return path
+}
+
+// WriteJSON writes the schedule as indented JSON
+func (s *Schedule) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// WriteCSV writes one row per task with a header row
+func (s *Schedule) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := []string{"vertex", "name", "duration", "es", "ef", "ls", "lf", "slack", "critical"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	number := func(x float64) string { return strconv.FormatFloat(x, 'f', -1, 64) }
+	for _, t := range s.Tasks {
+		row := []string{
+			strconv.Itoa(t.Vertex), t.Name, number(t.Duration),
+			number(t.ES), number(t.EF), number(t.LS), number(t.LF), number(t.Slack),
+			strconv.FormatBool(t.Critical),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,162 @@
+package graph
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// Test helper: A(3) -> B(2) -> D(1) -> E(2) and A -> C(4) -> E
+func textbookProject() (*Graph, []float64) {
+	g := NewGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 4)
+	g.AddEdge(3, 4)
+	return g, []float64{3, 2, 4, 1, 2}
+}
+
+// Test helper: formats the ES/EF/LS/LF/slack figures of every task
+func taskTimes(s *Schedule) string {
+	out := ""
+	for _, t := range s.Tasks {
+		out += fmt.Sprintf("%v %v %v %v %v %v;", t.ES, t.EF, t.LS, t.LF, t.Slack, t.Critical)
+	}
+	return out
+}
+
+func TestCriticalPath(t *testing.T) {
+	g, durations := textbookProject()
+	s, err := g.CriticalPath(durations)
+	if err != nil {
+		t.Fatalf("CriticalPath error = %v", err)
+	}
+
+	want := "0 3 0 3 0 true;3 5 4 6 1 false;3 7 3 7 0 true;5 6 6 7 1 false;7 9 7 9 0 true;"
+	if got := taskTimes(s); got != want {
+		t.Errorf("Tasks = %s; want %s", got, want)
+	}
+	if s.Duration != 9 || fmt.Sprint(s.CriticalPath) != "[0 2 4]" {
+		t.Errorf("Duration, CriticalPath = %v, %v; want 9, [0 2 4]", s.Duration, s.CriticalPath)
+	}
+}
+
+func TestCriticalPathWithLags(t *testing.T) {
+	// Two units of curing time between A and C push C and E back
+	g, durations := textbookProject()
+	s, err := g.CriticalPathWithLags(durations, func(from, to int) float64 {
+		if from == 0 && to == 2 {
+			return 2
+		}
+		return 0
+	})
+	if err != nil {
+		t.Fatalf("CriticalPathWithLags error = %v", err)
+	}
+
+	want := "0 3 0 3 0 true;3 5 6 8 3 false;5 9 5 9 0 true;5 6 8 9 3 false;9 11 9 11 0 true;"
+	if got := taskTimes(s); got != want {
+		t.Errorf("Tasks = %s; want %s", got, want)
+	}
+	if s.Duration != 11 || fmt.Sprint(s.CriticalPath) != "[0 2 4]" {
+		t.Errorf("Duration, CriticalPath = %v, %v; want 11, [0 2 4]", s.Duration, s.CriticalPath)
+	}
+}
+
+func TestCriticalPathSnapsRounding(t *testing.T) {
+	// Without snapping the backward pass leaves slack of about 1e-16 on tasks 0 and 1
+	g := NewGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	s, err := g.CriticalPathWithLags([]float64{0.1, 0.2, 0.7}, func(int, int) float64 { return 0.5 })
+	if err != nil {
+		t.Fatalf("CriticalPathWithLags error = %v", err)
+	}
+
+	for _, task := range s.Tasks {
+		if !task.Critical || task.Slack != 0 || task.LS != task.ES || task.LF != task.EF {
+			t.Errorf("Task %d = %+v; want critical with zero slack", task.Vertex, task)
+		}
+	}
+	if fmt.Sprint(s.CriticalPath) != "[0 1 2]" {
+		t.Errorf("CriticalPath = %v; want [0 1 2]", s.CriticalPath)
+	}
+}
+
+func TestCriticalPathErrors(t *testing.T) {
+	g, _ := textbookProject()
+	if _, err := g.CriticalPath([]float64{1, 2}); err == nil {
+		t.Error("CriticalPath should reject a duration count that does not match the vertices")
+	}
+	if _, err := g.CriticalPath([]float64{1, 2, -1, 1, 1}); err == nil {
+		t.Error("CriticalPath should reject a negative duration")
+	}
+
+	var cycleErr *CycleError
+	if _, err := cyclicGraph().CriticalPath([]float64{1, 1, 1}); !errors.As(err, &cycleErr) {
+		t.Errorf("CriticalPath error = %v; want *CycleError", err)
+	}
+}
+
+func TestScheduleExport(t *testing.T) {
+	g := NewGraph(2)
+	g.AddEdge(0, 1)
+	s, err := g.CriticalPath([]float64{1.5, 2})
+	if err != nil {
+		t.Fatalf("CriticalPath error = %v", err)
+	}
+	s.Tasks[0].Name = "Design, review"
+
+	var csvOut bytes.Buffer
+	if err := s.WriteCSV(&csvOut); err != nil {
+		t.Fatalf("WriteCSV error = %v", err)
+	}
+	wantCSV := `vertex,name,duration,es,ef,ls,lf,slack,critical
+0,"Design, review",1.5,0,1.5,0,1.5,0,true
+1,,2,1.5,3.5,1.5,3.5,0,true
+`
+	if csvOut.String() != wantCSV {
+		t.Errorf("WriteCSV =\n%s\nwant\n%s", csvOut.String(), wantCSV)
+	}
+
+	var jsonOut bytes.Buffer
+	if err := s.WriteJSON(&jsonOut); err != nil {
+		t.Fatalf("WriteJSON error = %v", err)
+	}
+	wantJSON := `{
+  "tasks": [
+    {
+      "vertex": 0,
+      "name": "Design, review",
+      "duration": 1.5,
+      "earliestStart": 0,
+      "earliestFinish": 1.5,
+      "latestStart": 0,
+      "latestFinish": 1.5,
+      "slack": 0,
+      "critical": true
+    },
+    {
+      "vertex": 1,
+      "duration": 2,
+      "earliestStart": 1.5,
+      "earliestFinish": 3.5,
+      "latestStart": 1.5,
+      "latestFinish": 3.5,
+      "slack": 0,
+      "critical": true
+    }
+  ],
+  "duration": 3.5,
+  "criticalPath": [
+    0,
+    1
+  ]
+}
+`
+	if jsonOut.String() != wantJSON {
+		t.Errorf("WriteJSON =\n%s\nwant\n%s", jsonOut.String(), wantJSON)
+	}
+}
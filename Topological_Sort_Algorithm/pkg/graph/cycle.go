@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CycleError explains why a graph has no topological order
+type CycleError struct {
+	Cycle       []int // One cycle as a closed walk, e.g. [0 1 2 0]
+	Unorderable []int // Every vertex on or downstream of a cycle, sorted
+}
+
+// Error describes the cycle using vertex ids
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("graph has a cycle: %s (%d vertices cannot be ordered)",
+		e.Format(strconv.Itoa), len(e.Unorderable))
+}
+
+// Format renders the cycle as "a -> b -> c -> a" using name for each vertex
+func (e *CycleError) Format(name func(v int) string) string {
+	steps := make([]string, len(e.Cycle))
+	for i, v := range e.Cycle {
+		steps[i] = name(v)
+	}
+	return strings.Join(steps, " -> ")
+}
+
+// TopologicalOrder returns the same order as TopologicalSort, or a *CycleError
+// describing a cycle when the graph has one
+func (g *Graph) TopologicalOrder() ([]int, error) {
+	if order, hasCycle := g.TopologicalSort(); !hasCycle {
+		return order, nil
+	}
+	return nil, g.checkCycle()
+}
+
+// checkCycle returns a *CycleError describing the graph's cycles, or nil if it is
+// acyclic. It is unexported because a nil *CycleError stored in an error
+// variable would not compare equal to nil; callers use TopologicalOrder instead.
+func (g *Graph) checkCycle() *CycleError {
+	_, inDegree := g.release(&fifo{})
+
+	// Vertices Kahn's algorithm could not release are exactly those on or behind a cycle
+	unorderable := []int{}
+	for v := 0; v < g.V; v++ {
+		if inDegree[v] > 0 {
+			unorderable = append(unorderable, v)
+		}
+	}
+	if len(unorderable) == 0 {
+		return nil
+	}
+	return &CycleError{
+		Cycle:       g.cycleAmong(inDegree),
+		Unorderable: unorderable,
+	}
+}
+
+// FindCycle returns one cycle as a closed walk starting at its smallest vertex,
+// such as [0 1 2 0], or nil if the graph is acyclic
+func (g *Graph) FindCycle() []int {
+	if err := g.checkCycle(); err != nil {
+		return err.Cycle
+	}
+	return nil
+}
+
+// cycleAmong finds a cycle among the unreleased vertices (positive inDegree).
+// Each of them still has an unreleased predecessor, so walking predecessors
+// from any of them must eventually repeat a vertex.
+func (g *Graph) cycleAmong(inDegree []int) []int {
+	pred := make([]int, g.V)
+	for v := range pred {
+		pred[v] = -1
+	}
+	for v := 0; v < g.V; v++ {
+		if inDegree[v] == 0 {
+			continue
+		}
+		for _, w := range g.Adj[v] {
+			if pred[w] == -1 {
+				pred[w] = v
+			}
+		}
+	}
+
+	start := 0
+	for inDegree[start] == 0 {
+		start++
+	}
+
+	// Walk backwards until a vertex repeats
+	position := make(map[int]int)
+	walk := []int{}
+	v := start
+	for {
+		if i, seen := position[v]; seen {
+			walk = walk[i:]
+			break
+		}
+		position[v] = len(walk)
+		walk = append(walk, v)
+		v = pred[v]
+	}
+
+	// The walk follows edges in reverse; flip it, start from the smallest vertex
+	// and close the loop
+	cycle := make([]int, 0, len(walk))
+	for i := len(walk) - 1; i >= 0; i-- {
+		cycle = append(cycle, walk[i])
+	}
+	smallest := 0
+	for i, v := range cycle {
+		if v < cycle[smallest] {
+			smallest = i
+		}
+	}
+	rotated := append([]int{}, cycle[smallest:]...)
+	rotated = append(rotated, cycle[:smallest]...)
+	return append(rotated, rotated[0])
+}
@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestCheckCycleSelfLoop(t *testing.T) {
+	g := NewGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 1)
+
+	err := g.checkCycle()
+	if err == nil {
+		t.Fatal("A self-loop is a cycle")
+	}
+	if fmt.Sprint(err.Cycle) != "[1 1]" {
+		t.Errorf("Cycle = %v; want [1 1]", err.Cycle)
+	}
+	if fmt.Sprint(err.Unorderable) != "[1]" {
+		t.Errorf("Unorderable = %v; want [1]", err.Unorderable)
+	}
+}
+
+func TestCheckCycleDownstreamVertex(t *testing.T) {
+	// 0 -> 1 <-> 2 -> 3: vertex 3 sits behind the two-cycle, vertex 0 in front of it
+	g := NewGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 1)
+	g.AddEdge(2, 3)
+
+	err := g.checkCycle()
+	if err == nil {
+		t.Fatal("Expected a cycle")
+	}
+	if fmt.Sprint(err.Cycle) != "[1 2 1]" {
+		t.Errorf("Cycle = %v; want [1 2 1]", err.Cycle)
+	}
+	if fmt.Sprint(err.Unorderable) != "[1 2 3]" {
+		t.Errorf("Unorderable = %v; want [1 2 3]", err.Unorderable)
+	}
+	if fmt.Sprint(g.FindCycle()) != "[1 2 1]" {
+		t.Errorf("FindCycle = %v; want [1 2 1]", g.FindCycle())
+	}
+}
+
+func TestCycleErrorFormat(t *testing.T) {
+	g := NewGraph(4)
+	g.AddEdge(3, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+
+	_, err := g.TopologicalOrder()
+	var cycleErr *CycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("TopologicalOrder error = %v; want *CycleError", err)
+	}
+
+	names := []string{"lint", "fetch", "build", "deploy"}
+	if got := cycleErr.Format(func(v int) string { return names[v] }); got != "fetch -> build -> deploy -> fetch" {
+		t.Errorf("Format = %q", got)
+	}
+	if got := cycleErr.Format(strconv.Itoa); got != "1 -> 2 -> 3 -> 1" {
+		t.Errorf("Format(strconv.Itoa) = %q", got)
+	}
+	if got := err.Error(); got != "graph has a cycle: 1 -> 2 -> 3 -> 1 (3 vertices cannot be ordered)" {
+		t.Errorf("Error = %q", got)
+	}
+}
+
+func TestCheckCycleAcyclic(t *testing.T) {
+	g := NewGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	if err := g.checkCycle(); err != nil {
+		t.Errorf("checkCycle = %v; want nil", err)
+	}
+	if g.FindCycle() != nil {
+		t.Error("FindCycle should be nil for a DAG")
+	}
+	if order, err := g.TopologicalOrder(); err != nil || fmt.Sprint(order) != "[0 2 1]" {
+		t.Errorf("TopologicalOrder = %v, %v; want [0 2 1]", order, err)
+	}
+}
@@ -0,0 +1,177 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Task is a unit of work attached to a vertex
+type Task func(ctx context.Context) error
+
+// FailurePolicy decides what the executor does after a task fails
+type FailurePolicy int
+
+const (
+	// FailFast cancels the running tasks and starts nothing new after the first failure
+	FailFast FailurePolicy = iota
+	// ContinueOnError skips only the failed task's descendants and keeps running
+	// everything that does not depend on it
+	ContinueOnError
+)
+
+// TaskStatus is the outcome of one vertex's task
+type TaskStatus int
+
+const (
+	Pending   TaskStatus = iota // Not started yet; never returned by Execute
+	Succeeded                   // Task returned nil
+	Failed                      // Task returned an error
+	Skipped                     // A dependency failed, so the task never started
+	Canceled                    // Stopped by fail-fast or by the caller's context
+)
+
+// String returns the status name
+func (s TaskStatus) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Succeeded:
+		return "succeeded"
+	case Failed:
+		return "failed"
+	case Skipped:
+		return "skipped"
+	case Canceled:
+		return "canceled"
+	}
+	return fmt.Sprintf("TaskStatus(%d)", int(s))
+}
+
+// TaskError wraps the error returned by the task of Vertex
+type TaskError struct {
+	Vertex int
+	Err    error
+}
+
+// Error prefixes the task's error with its vertex
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("task %d: %v", e.Vertex, e.Err)
+}
+
+// Unwrap returns the task's error
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// Execute runs tasks[v] for every vertex v, starting each task as soon as all of
+// its predecessors have succeeded and running at most parallelism tasks at once
+// (parallelism <= 0 means no limit). A nil task succeeds immediately.
+//
+// When a task fails its descendants are skipped. Under FailFast the context passed
+// to running tasks is also canceled and no further tasks start. Canceling ctx
+// stops the run the same way.
+//
+// Execute returns the status of every vertex and an error joining a *TaskError
+// for each failure (plus ctx's error if the caller canceled the run), or a
+// *CycleError without running anything if the graph has a cycle.
+func (g *Graph) Execute(ctx context.Context, tasks []Task, parallelism int, policy FailurePolicy) ([]TaskStatus, error) {
+	if len(tasks) != g.V {
+		return nil, fmt.Errorf("got %d tasks for %d vertices", len(tasks), g.V)
+	}
+	if err := g.checkCycle(); err != nil {
+		return nil, err
+	}
+	if parallelism <= 0 {
+		parallelism = max(g.V, 1)
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type completion struct {
+		vertex int
+		err    error
+	}
+	done := make(chan completion)
+	run := func(v int) {
+		var err error
+		if tasks[v] != nil {
+			err = tasks[v](runCtx)
+		}
+		done <- completion{vertex: v, err: err}
+	}
+
+	status := make([]TaskStatus, g.V)
+	inDegree := g.InDegrees()
+	ready := &fifo{}
+	for v := 0; v < g.V; v++ {
+		if inDegree[v] == 0 {
+			ready.add(v)
+		}
+	}
+
+	failures := []error{}
+	running := 0
+	stopping := false
+	for {
+		if ctx.Err() != nil {
+			stopping = true
+		}
+		for !stopping && running < parallelism && ready.size() > 0 {
+			running++
+			go run(ready.take())
+		}
+		if running == 0 {
+			break
+		}
+
+		c := <-done
+		running--
+		switch {
+		case c.err == nil:
+			status[c.vertex] = Succeeded
+			for _, w := range g.Adj[c.vertex] {
+				inDegree[w]--
+				if inDegree[w] == 0 && status[w] == Pending {
+					ready.add(w)
+				}
+			}
+		case runCtx.Err() != nil && (errors.Is(c.err, context.Canceled) || errors.Is(c.err, context.DeadlineExceeded)):
+			status[c.vertex] = Canceled // interrupted by an earlier failure or the caller
+		default:
+			status[c.vertex] = Failed
+			failures = append(failures, &TaskError{Vertex: c.vertex, Err: c.err})
+			g.skipDescendants(c.vertex, status)
+			if policy == FailFast {
+				stopping = true
+				cancel()
+			}
+		}
+	}
+
+	for v := range status {
+		if status[v] == Pending {
+			status[v] = Canceled
+		}
+	}
+	if ctx.Err() != nil {
+		failures = append(failures, ctx.Err())
+	}
+	return status, errors.Join(failures...)
+}
+
+// skipDescendants marks every pending vertex reachable from v as Skipped
+func (g *Graph) skipDescendants(v int, status []TaskStatus) {
+	stack := []int{v}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, w := range g.Adj[u] {
+			if status[w] == Pending {
+				status[w] = Skipped
+				stack = append(stack, w)
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var errBoom = errors.New("boom")
+
+func TestExecuteRespectsParallelismAndDependencies(t *testing.T) {
+	// Twelve independent chains of length two: 2i -> 2i+1
+	const n = 24
+	g := NewGraph(n)
+	for v := 0; v < n; v += 2 {
+		g.AddEdge(v, v+1)
+	}
+
+	var running, peak atomic.Int32
+	finished := make([]atomic.Bool, n)
+	tasks := make([]Task, n)
+	for v := range tasks {
+		v := v
+		tasks[v] = func(ctx context.Context) error {
+			if v%2 == 1 && !finished[v-1].Load() {
+				return fmt.Errorf("started before its predecessor %d finished", v-1)
+			}
+			now := running.Add(1)
+			for old := peak.Load(); now > old && !peak.CompareAndSwap(old, now); old = peak.Load() {
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+			finished[v].Store(true)
+			return nil
+		}
+	}
+
+	status, err := g.Execute(context.Background(), tasks, 3, FailFast)
+	if err != nil {
+		t.Fatalf("Execute error = %v", err)
+	}
+	for v, s := range status {
+		if s != Succeeded {
+			t.Errorf("status[%d] = %v; want succeeded", v, s)
+		}
+	}
+	if p := peak.Load(); p > 3 {
+		t.Errorf("%d tasks ran at once; parallelism is 3", p)
+	}
+}
+
+func TestExecuteFailFast(t *testing.T) {
+	// 0 fails once 1 is running; 2 depends on 0; 3 waits for a free slot
+	g := NewGraph(4)
+	g.AddEdge(0, 2)
+
+	started := make(chan struct{})
+	var lateStart atomic.Bool
+	tasks := []Task{
+		func(ctx context.Context) error {
+			<-started
+			return errBoom
+		},
+		func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		func(ctx context.Context) error { lateStart.Store(true); return nil },
+		func(ctx context.Context) error { lateStart.Store(true); return nil },
+	}
+
+	status, err := g.Execute(context.Background(), tasks, 2, FailFast)
+	if fmt.Sprint(status) != "[failed canceled skipped canceled]" {
+		t.Errorf("status = %v; want [failed canceled skipped canceled]", status)
+	}
+	if lateStart.Load() {
+		t.Error("A task started after the failure")
+	}
+
+	var taskErr *TaskError
+	if !errors.As(err, &taskErr) || taskErr.Vertex != 0 || !errors.Is(err, errBoom) {
+		t.Errorf("Execute error = %v; want task 0: boom", err)
+	}
+	if errors.Is(err, context.Canceled) {
+		t.Errorf("Execute error = %v; the caller did not cancel", err)
+	}
+}
+
+func TestExecuteContinueOnError(t *testing.T) {
+	// 0 -> 1 -> 2 -> 5, 0 -> 6, 3 -> 4; task 1 fails
+	g := NewGraph(7)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 5)
+	g.AddEdge(0, 6)
+	g.AddEdge(3, 4)
+
+	var ran [7]atomic.Bool
+	tasks := make([]Task, 7)
+	for v := range tasks {
+		v := v
+		tasks[v] = func(ctx context.Context) error {
+			ran[v].Store(true)
+			if v == 1 {
+				return errBoom
+			}
+			return nil
+		}
+	}
+
+	status, err := g.Execute(context.Background(), tasks, 2, ContinueOnError)
+	want := "[succeeded failed skipped succeeded succeeded skipped succeeded]"
+	if fmt.Sprint(status) != want {
+		t.Errorf("status = %v; want %s", status, want)
+	}
+	if ran[2].Load() || ran[5].Load() {
+		t.Error("A descendant of the failed task ran")
+	}
+	if err == nil || err.Error() != "task 1: boom" {
+		t.Errorf("Execute error = %v; want task 1: boom", err)
+	}
+}
+
+func TestExecuteCallerDeadline(t *testing.T) {
+	g := NewGraph(3)
+	g.AddEdge(0, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	tasks := []Task{
+		func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		nil,
+		nil,
+	}
+
+	status, err := g.Execute(ctx, tasks, 1, ContinueOnError)
+	if fmt.Sprint(status) != "[canceled canceled canceled]" {
+		t.Errorf("status = %v; want [canceled canceled canceled]", status)
+	}
+	var taskErr *TaskError
+	if !errors.Is(err, ctx.Err()) || !errors.Is(err, context.DeadlineExceeded) || errors.As(err, &taskErr) {
+		t.Errorf("Execute error = %v; want only %v", err, ctx.Err())
+	}
+}
+
+func TestExecuteRejectsCycle(t *testing.T) {
+	var ran atomic.Bool
+	task := func(ctx context.Context) error { ran.Store(true); return nil }
+
+	status, err := cyclicGraph().Execute(context.Background(), []Task{task, task, task}, 0, FailFast)
+	var cycleErr *CycleError
+	if !errors.As(err, &cycleErr) || status != nil {
+		t.Errorf("Execute = %v, %v; want a *CycleError", status, err)
+	}
+	if ran.Load() {
+		t.Error("A task ran on a cyclic graph")
+	}
+
+	if _, err := exampleGraph().Execute(context.Background(), make([]Task, 2), 0, FailFast); err == nil {
+		t.Error("Execute should reject a task count that does not match the vertices")
+	}
+}
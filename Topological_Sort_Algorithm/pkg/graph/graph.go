@@ -38,6 +38,10 @@ func (g *Graph) TopologicalSort() ([]int, bool) {
 		}
 	}
 
+	// Vertices were appended in finishing order; reverse them
+	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
+		stack[i], stack[j] = stack[j], stack[i]
+	}
 	return stack, false
 }
 
@@ -63,7 +67,7 @@ func (g *Graph) topologicalSortUtil(v int, visited, recStack []bool, stack *[]in
 
 	// Remove vertex from recursion stack and add to result
 	recStack[v] = false
-	*stack = append([]int{v}, *stack...)
+	*stack = append(*stack, v)
 
 	return false
 }
@@ -0,0 +1,134 @@
+package graph
+
+import "container/heap"
+
+// TieBreak selects which vertex Kahn's algorithm emits when several are ready
+type TieBreak int
+
+const (
+	// SmallestID emits the ready vertex with the lowest id first
+	SmallestID TieBreak = iota
+	// InsertionOrder emits ready vertices first-in first-out: the initial sources
+	// by id, then each vertex in the order its last incoming edge was released,
+	// following the order edges were added
+	InsertionOrder
+)
+
+// KahnSort performs topological sorting with Kahn's algorithm, repeatedly removing
+// a vertex with no remaining incoming edges. The result depends only on the graph
+// and the tie-break, so it is reproducible from run to run.
+// Returns the sorted vertices and whether the graph has a cycle.
+func (g *Graph) KahnSort(tie TieBreak) ([]int, bool) {
+	if tie == InsertionOrder {
+		return g.kahn(&fifo{})
+	}
+	return g.KahnSortFunc(func(a, b int) bool { return a < b })
+}
+
+// KahnSortFunc performs Kahn's algorithm, emitting the ready vertex that less ranks
+// first each time. less must be a strict weak ordering; for example, ranking by
+// a caller-supplied priority with the id as a tie-break gives a deterministic order.
+// Returns the sorted vertices and whether the graph has a cycle.
+func (g *Graph) KahnSortFunc(less func(a, b int) bool) ([]int, bool) {
+	return g.kahn(&priorityQueue{less: less})
+}
+
+// KahnSortByPriority emits the ready vertex with the highest priority first,
+// breaking ties by the smaller id
+func (g *Graph) KahnSortByPriority(priority func(v int) int) ([]int, bool) {
+	return g.KahnSortFunc(func(a, b int) bool {
+		if pa, pb := priority(a), priority(b); pa != pb {
+			return pa > pb
+		}
+		return a < b
+	})
+}
+
+// InDegrees returns the number of incoming edges of every vertex
+func (g *Graph) InDegrees() []int {
+	inDegree := make([]int, g.V)
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			inDegree[w]++
+		}
+	}
+	return inDegree
+}
+
+// readySet holds the vertices whose dependencies have all been emitted
+type readySet interface {
+	add(v int)
+	take() int
+	size() int
+}
+
+// kahn runs Kahn's algorithm, using ready to choose among the available vertices
+func (g *Graph) kahn(ready readySet) ([]int, bool) {
+	order, _ := g.release(ready)
+	if len(order) < g.V {
+		return nil, true // The remaining vertices sit on or behind a cycle
+	}
+	return order, false
+}
+
+// release emits vertices as their incoming edges are used up. It returns the
+// emitted order and the in-degrees left over, which are positive exactly for the
+// vertices that sit on or behind a cycle.
+func (g *Graph) release(ready readySet) ([]int, []int) {
+	inDegree := g.InDegrees()
+	for v := 0; v < g.V; v++ {
+		if inDegree[v] == 0 {
+			ready.add(v)
+		}
+	}
+
+	order := make([]int, 0, g.V)
+	for ready.size() > 0 {
+		v := ready.take()
+		order = append(order, v)
+		for _, w := range g.Adj[v] {
+			inDegree[w]--
+			if inDegree[w] == 0 {
+				ready.add(w)
+			}
+		}
+	}
+
+	return order, inDegree
+}
+
+// fifo is a readySet that preserves the order vertices became ready
+type fifo struct {
+	items []int
+	head  int
+}
+
+func (q *fifo) add(v int) { q.items = append(q.items, v) }
+func (q *fifo) size() int { return len(q.items) - q.head }
+
+func (q *fifo) take() int {
+	v := q.items[q.head]
+	q.head++
+	return v
+}
+
+// priorityQueue is a readySet ordered by a caller-supplied comparison
+type priorityQueue struct {
+	items []int
+	less  func(a, b int) bool
+}
+
+func (q *priorityQueue) Len() int           { return len(q.items) }
+func (q *priorityQueue) Less(i, j int) bool { return q.less(q.items[i], q.items[j]) }
+func (q *priorityQueue) Swap(i, j int)      { q.items[i], q.items[j] = q.items[j], q.items[i] }
+func (q *priorityQueue) Push(x any)         { q.items = append(q.items, x.(int)) }
+
+func (q *priorityQueue) Pop() any {
+	v := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	return v
+}
+
+func (q *priorityQueue) add(v int) { heap.Push(q, v) }
+func (q *priorityQueue) take() int { return heap.Pop(q).(int) }
+func (q *priorityQueue) size() int { return len(q.items) }
@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Test helper: the six-vertex example graph 5->2, 5->0, 4->0, 4->3, 2->3, 3->1
+func exampleGraph() *Graph {
+	g := NewGraph(6)
+	g.AddEdge(5, 2)
+	g.AddEdge(5, 0)
+	g.AddEdge(4, 0)
+	g.AddEdge(4, 3)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	return g
+}
+
+// Test helper: two-cycle 1 <-> 2 reachable from 0
+func cyclicGraph() *Graph {
+	g := NewGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 1)
+	return g
+}
+
+func TestKahnOrders(t *testing.T) {
+	priority := map[int]int{3: 10, 2: 5}
+	tests := []struct {
+		name string
+		sort func(g *Graph) ([]int, bool)
+		want string
+	}{
+		{"SmallestID", func(g *Graph) ([]int, bool) { return g.KahnSort(SmallestID) }, "[4 5 0 2 3 1]"},
+		{"InsertionOrder", func(g *Graph) ([]int, bool) { return g.KahnSort(InsertionOrder) }, "[4 5 2 0 3 1]"},
+		{"ByPriority", func(g *Graph) ([]int, bool) {
+			return g.KahnSortByPriority(func(v int) int { return priority[v] })
+		}, "[4 5 2 3 0 1]"},
+		{"LargestIDFunc", func(g *Graph) ([]int, bool) {
+			return g.KahnSortFunc(func(a, b int) bool { return a > b })
+		}, "[5 4 2 3 1 0]"},
+	}
+
+	for _, tt := range tests {
+		order, hasCycle := tt.sort(exampleGraph())
+		if hasCycle || fmt.Sprint(order) != tt.want {
+			t.Errorf("%s = %v, %v; want %s", tt.name, order, hasCycle, tt.want)
+		}
+		if _, hasCycle := tt.sort(cyclicGraph()); !hasCycle {
+			t.Errorf("%s should report the cycle 1 <-> 2", tt.name)
+		}
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	// Vertices come out in reverse finishing order of the depth-first search
+	order, hasCycle := exampleGraph().TopologicalSort()
+	if hasCycle || fmt.Sprint(order) != "[5 4 2 3 1 0]" {
+		t.Errorf("TopologicalSort = %v, %v; want [5 4 2 3 1 0]", order, hasCycle)
+	}
+
+	courses := NewGraph(4)
+	courses.AddEdge(0, 2)
+	courses.AddEdge(1, 2)
+	courses.AddEdge(2, 3)
+	courses.AddEdge(0, 1)
+	if order, _ := courses.TopologicalSort(); fmt.Sprint(order) != "[0 1 2 3]" {
+		t.Errorf("TopologicalSort = %v; want [0 1 2 3]", order)
+	}
+
+	if _, hasCycle := cyclicGraph().TopologicalSort(); !hasCycle {
+		t.Error("TopologicalSort should report the cycle 1 <-> 2")
+	}
+	if !cyclicGraph().HasCycle() || exampleGraph().HasCycle() {
+		t.Error("HasCycle disagrees with TopologicalSort")
+	}
+}
+
+func TestInDegrees(t *testing.T) {
+	if got := exampleGraph().InDegrees(); fmt.Sprint(got) != "[2 1 1 2 0 0]" {
+		t.Errorf("InDegrees = %v; want [2 1 1 2 0 0]", got)
+	}
+}
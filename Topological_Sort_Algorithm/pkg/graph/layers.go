@@ -0,0 +1,43 @@
+package graph
+
+import "sort"
+
+// Layers groups the vertices into antichains: layer 0 holds the vertices with no
+// incoming edges and every other vertex sits one layer below its deepest
+// predecessor. No edge joins two vertices of the same layer, so each layer can run
+// concurrently once the layers before it have finished. Each layer is sorted.
+// Returns a *CycleError if the graph has a cycle.
+func (g *Graph) Layers() ([][]int, error) {
+	inDegree := g.InDegrees()
+	current := []int{}
+	for v := 0; v < g.V; v++ {
+		if inDegree[v] == 0 {
+			current = append(current, v)
+		}
+	}
+
+	layers := [][]int{}
+	placed := 0
+	for len(current) > 0 {
+		layers = append(layers, current)
+		placed += len(current)
+
+		// Vertices move to the next layer once their last predecessor is placed
+		next := []int{}
+		for _, v := range current {
+			for _, w := range g.Adj[v] {
+				inDegree[w]--
+				if inDegree[w] == 0 {
+					next = append(next, w)
+				}
+			}
+		}
+		sort.Ints(next)
+		current = next
+	}
+
+	if placed < g.V {
+		return nil, g.checkCycle()
+	}
+	return layers, nil
+}
@@ -0,0 +1,40 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestLayers(t *testing.T) {
+	g := exampleGraph()
+	layers, err := g.Layers()
+	if err != nil || fmt.Sprint(layers) != "[[4 5] [0 2] [3] [1]]" {
+		t.Fatalf("Layers = %v, %v; want [[4 5] [0 2] [3] [1]]", layers, err)
+	}
+
+	// Every vertex appears once and no edge joins two vertices of the same layer
+	layerOf := make([]int, g.V)
+	seen := 0
+	for i, layer := range layers {
+		for _, v := range layer {
+			layerOf[v] = i
+			seen++
+		}
+	}
+	if seen != g.V {
+		t.Errorf("Layers placed %d of %d vertices", seen, g.V)
+	}
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			if layerOf[w] <= layerOf[v] {
+				t.Errorf("Edge %d -> %d goes from layer %d to layer %d", v, w, layerOf[v], layerOf[w])
+			}
+		}
+	}
+
+	var cycleErr *CycleError
+	if _, err := cyclicGraph().Layers(); !errors.As(err, &cycleErr) {
+		t.Errorf("Layers error = %v; want *CycleError", err)
+	}
+}
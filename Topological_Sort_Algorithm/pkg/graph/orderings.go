@@ -0,0 +1,214 @@
+package graph
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+)
+
+// MaxSubsetVertices is the largest graph CountTopologicalSorts and
+// UniformTopologicalSort accept; both keep a table over all 2^V vertex subsets
+const MaxSubsetVertices = 20
+
+// AllTopologicalSorts streams every topological ordering to visit, in
+// lexicographic order. The slice is reused between calls, so copy it to keep it.
+// Returning false from visit stops the enumeration, and a positive limit caps the
+// number of orderings produced. It returns the number of orderings visited;
+// a graph with a cycle has none.
+func (g *Graph) AllTopologicalSorts(limit int, visit func(order []int) bool) int {
+	inDegree := g.InDegrees()
+	placed := make([]bool, g.V)
+	order := make([]int, 0, g.V)
+	stack := []int{-1} // Vertex placed at each position, or -1 before the first choice
+	count := 0
+
+	// place and unplace keep in-degrees in sync with the partial order
+	place := func(v int) {
+		placed[v] = true
+		order = append(order, v)
+		for _, w := range g.Adj[v] {
+			inDegree[w]--
+		}
+	}
+	unplace := func(v int) {
+		placed[v] = false
+		order = order[:len(order)-1]
+		for _, w := range g.Adj[v] {
+			inDegree[w]++
+		}
+	}
+
+	for len(stack) > 0 {
+		top := len(stack) - 1
+		if len(order) == g.V && stack[top] == -1 {
+			count++
+			if (visit != nil && !visit(order)) || (limit > 0 && count >= limit) {
+				return count
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		// Undo the previous choice at this position and try the next ready vertex
+		next := stack[top] + 1
+		if stack[top] != -1 {
+			unplace(stack[top])
+		}
+		for next < g.V && (placed[next] || inDegree[next] != 0) {
+			next++
+		}
+		if next == g.V {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		stack[top] = next
+		place(next)
+		stack = append(stack, -1)
+	}
+	return count
+}
+
+// TopologicalSorts collects up to limit topological orderings (all of them if
+// limit is 0) in lexicographic order
+func (g *Graph) TopologicalSorts(limit int) [][]int {
+	orders := [][]int{}
+	g.AllTopologicalSorts(limit, func(order []int) bool {
+		orders = append(orders, append([]int(nil), order...))
+		return true
+	})
+	return orders
+}
+
+// CountTopologicalSorts counts the topological orderings with dynamic programming
+// over vertex subsets in O(2^V * V) time. Graphs above MaxSubsetVertices vertices
+// are rejected and a cycle yields a *CycleError.
+func (g *Graph) CountTopologicalSorts() (*big.Int, error) {
+	completions, err := g.completionCounts()
+	if err != nil {
+		return nil, err
+	}
+	return completions[0], nil
+}
+
+// UniformTopologicalSort draws a topological ordering uniformly at random by
+// choosing each next vertex in proportion to the number of ways to finish the
+// ordering after it. It has the same size limit as CountTopologicalSorts.
+func (g *Graph) UniformTopologicalSort(rng *rand.Rand) ([]int, error) {
+	completions, err := g.completionCounts()
+	if err != nil {
+		return nil, err
+	}
+	predecessors := g.predecessorMasks()
+
+	order := make([]int, 0, g.V)
+	pick := new(big.Int)
+	for mask := 0; len(order) < g.V; {
+		// pick is uniform in [0, completions[mask]); walk the ready vertices until it lands
+		pick.Rand(rng, completions[mask])
+		for v := 0; v < g.V; v++ {
+			if mask&(1<<v) != 0 || predecessors[v]&^mask != 0 {
+				continue
+			}
+			ways := completions[mask|1<<v]
+			if pick.Cmp(ways) < 0 {
+				order = append(order, v)
+				mask |= 1 << v
+				break
+			}
+			pick.Sub(pick, ways)
+		}
+	}
+	return order, nil
+}
+
+// SampleTopologicalSort draws an approximately uniform topological ordering of a
+// graph of any size with the Karzanov-Khachiyan Markov chain: starting from Kahn's
+// order, each step picks a random adjacent pair and, with probability 1/2, swaps it
+// if no edge joins the two. The chain's stationary distribution is uniform, but
+// its proven mixing bounds grow like V^4 log V, far too many steps beyond a few
+// dozen vertices. The caller therefore chooses steps, trading closeness to
+// uniform for time; a small multiple of V^2 is a common practical choice, and
+// UniformTopologicalSort is exact for graphs up to MaxSubsetVertices.
+// Returns an error if steps is not positive and a *CycleError if the graph has a cycle.
+func (g *Graph) SampleTopologicalSort(rng *rand.Rand, steps int) ([]int, error) {
+	if steps <= 0 {
+		return nil, fmt.Errorf("sampling needs a positive number of steps, got %d", steps)
+	}
+	order, hasCycle := g.KahnSort(SmallestID)
+	if hasCycle {
+		return nil, g.checkCycle()
+	}
+	if g.V < 2 {
+		return order, nil
+	}
+
+	edge := make(map[[2]int]bool)
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			edge[[2]int{v, w}] = true
+		}
+	}
+
+	// Adjacent vertices in a topological order are comparable only through a direct edge
+	for ; steps > 0; steps-- {
+		i := rng.Intn(g.V - 1)
+		if rng.Intn(2) == 0 && !edge[[2]int{order[i], order[i+1]}] {
+			order[i], order[i+1] = order[i+1], order[i]
+		}
+	}
+	return order, nil
+}
+
+// completionCounts returns, for every downward-closed vertex subset mask, the
+// number of ways to order the remaining vertices. Other entries are nil.
+func (g *Graph) completionCounts() ([]*big.Int, error) {
+	if g.V > MaxSubsetVertices {
+		return nil, fmt.Errorf("%d vertices exceeds the subset DP limit of %d", g.V, MaxSubsetVertices)
+	}
+	if err := g.checkCycle(); err != nil {
+		return nil, err
+	}
+	predecessors := g.predecessorMasks()
+
+	// Mark the subsets that can be placed first, in increasing order
+	full := 1<<g.V - 1
+	reachable := make([]bool, full+1)
+	reachable[0] = true
+	for mask := 0; mask <= full; mask++ {
+		if !reachable[mask] {
+			continue
+		}
+		for v := 0; v < g.V; v++ {
+			if mask&(1<<v) == 0 && predecessors[v]&^mask == 0 {
+				reachable[mask|1<<v] = true
+			}
+		}
+	}
+
+	completions := make([]*big.Int, full+1)
+	completions[full] = big.NewInt(1)
+	for mask := full - 1; mask >= 0; mask-- {
+		if !reachable[mask] {
+			continue
+		}
+		total := new(big.Int)
+		for v := 0; v < g.V; v++ {
+			if mask&(1<<v) == 0 && predecessors[v]&^mask == 0 {
+				total.Add(total, completions[mask|1<<v])
+			}
+		}
+		completions[mask] = total
+	}
+	return completions, nil
+}
+
+// predecessorMasks returns, for each vertex, the bitmask of its direct predecessors
+func (g *Graph) predecessorMasks() []int {
+	masks := make([]int, g.V)
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			masks[w] |= 1 << v
+		}
+	}
+	return masks
+}
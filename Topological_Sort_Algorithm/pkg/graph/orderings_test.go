@@ -0,0 +1,146 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// Test helper: random DAG whose edges all point from a smaller to a larger id
+func randomDAG(rng *rand.Rand, n int) *Graph {
+	g := NewGraph(n)
+	for v := 0; v < n; v++ {
+		for w := v + 1; w < n; w++ {
+			if rng.Intn(3) == 0 {
+				g.AddEdge(v, w)
+			}
+		}
+	}
+	return g
+}
+
+// Test helper: reports whether order is a permutation of g's vertices respecting every edge
+func isTopological(g *Graph, order []int) bool {
+	if len(order) != g.V {
+		return false
+	}
+	position := make([]int, g.V)
+	for i := range position {
+		position[i] = -1
+	}
+	for i, v := range order {
+		if v < 0 || v >= g.V || position[v] != -1 {
+			return false
+		}
+		position[v] = i
+	}
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			if position[v] > position[w] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestAllTopologicalSortsMatchesCount(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 50; trial++ {
+		g := randomDAG(rng, 1+rng.Intn(8))
+		orders := g.TopologicalSorts(0)
+
+		count, err := g.CountTopologicalSorts()
+		if err != nil || count.Int64() != int64(len(orders)) {
+			t.Fatalf("trial %d: CountTopologicalSorts = %v, %v; enumeration found %d", trial, count, err, len(orders))
+		}
+		for i, order := range orders {
+			if !isTopological(g, order) {
+				t.Fatalf("trial %d: %v is not a topological order", trial, order)
+			}
+			if i > 0 && fmt.Sprint(orders[i-1]) >= fmt.Sprint(order) {
+				t.Fatalf("trial %d: %v does not follow %v lexicographically", trial, order, orders[i-1])
+			}
+		}
+	}
+
+	if count, _ := exampleGraph().CountTopologicalSorts(); count.Int64() != 11 {
+		t.Errorf("CountTopologicalSorts = %v; want 11", count)
+	}
+}
+
+func TestAllTopologicalSortsStops(t *testing.T) {
+	g := exampleGraph()
+	all := g.TopologicalSorts(0)
+
+	if got := g.TopologicalSorts(3); fmt.Sprint(got) != fmt.Sprint(all[:3]) {
+		t.Errorf("TopologicalSorts(3) = %v; want %v", got, all[:3])
+	}
+	visited := 0
+	if n := g.AllTopologicalSorts(0, func([]int) bool { visited++; return visited < 2 }); n != 2 || visited != 2 {
+		t.Errorf("AllTopologicalSorts stopped after %d visits, returned %d; want 2", visited, n)
+	}
+	if n := g.AllTopologicalSorts(5, nil); n != 5 {
+		t.Errorf("AllTopologicalSorts(5, nil) = %d; want 5", n)
+	}
+	if n := cyclicGraph().AllTopologicalSorts(0, nil); n != 0 {
+		t.Errorf("AllTopologicalSorts on a cycle = %d; want 0", n)
+	}
+}
+
+func TestSubsetLimit(t *testing.T) {
+	g := NewGraph(MaxSubsetVertices + 1)
+	var cycleErr *CycleError
+	if _, err := g.CountTopologicalSorts(); err == nil || errors.As(err, &cycleErr) {
+		t.Errorf("CountTopologicalSorts should reject %d vertices, got %v", g.V, err)
+	}
+	if _, err := g.UniformTopologicalSort(rand.New(rand.NewSource(1))); err == nil || errors.As(err, &cycleErr) {
+		t.Errorf("UniformTopologicalSort should reject %d vertices, got %v", g.V, err)
+	}
+	if _, err := cyclicGraph().CountTopologicalSorts(); !errors.As(err, &cycleErr) {
+		t.Errorf("CountTopologicalSorts error = %v; want *CycleError", err)
+	}
+}
+
+func TestUniformTopologicalSort(t *testing.T) {
+	g := exampleGraph()
+	rng := rand.New(rand.NewSource(2))
+	seen := map[string]int{}
+	for draw := 0; draw < 2000; draw++ {
+		order, err := g.UniformTopologicalSort(rng)
+		if err != nil || !isTopological(g, order) {
+			t.Fatalf("UniformTopologicalSort = %v, %v; want a topological order", order, err)
+		}
+		seen[fmt.Sprint(order)]++
+	}
+
+	// Each of the 11 orderings is expected about 182 times
+	if len(seen) != 11 {
+		t.Errorf("Drew %d distinct orderings; want 11", len(seen))
+	}
+	for order, n := range seen {
+		if n < 110 || n > 260 {
+			t.Errorf("Ordering %s drawn %d times out of 2000", order, n)
+		}
+	}
+}
+
+func TestSampleTopologicalSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	g := randomDAG(rng, 40)
+	for draw := 0; draw < 20; draw++ {
+		order, err := g.SampleTopologicalSort(rng, 10*g.V*g.V)
+		if err != nil || !isTopological(g, order) {
+			t.Fatalf("SampleTopologicalSort = %v, %v; want a topological order", order, err)
+		}
+	}
+
+	if _, err := g.SampleTopologicalSort(rng, 0); err == nil {
+		t.Error("SampleTopologicalSort should require a positive number of steps")
+	}
+	var cycleErr *CycleError
+	if _, err := cyclicGraph().SampleTopologicalSort(rng, 10); !errors.As(err, &cycleErr) {
+		t.Errorf("SampleTopologicalSort error = %v; want *CycleError", err)
+	}
+}